@@ -0,0 +1,315 @@
+// Package avro adds the records of Avro object container files to a
+// Meilisearch index.
+//
+// Records become documents with one field per record field. Nested records
+// and maps become JSON objects, arrays become JSON arrays and unions are
+// replaced by the value of their branch. Decimals are kept as exact JSON
+// numbers with the scale of their schema (e.g. 12.25 with a scale of 2) and
+// timestamps and dates are encoded in RFC 3339 format.
+package avro
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	"os"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/meilisearch/meilisearch-go"
+	"github.com/pkg/errors"
+)
+
+// AddDocumentsFromFile adds the records of the Avro file at path to index,
+// batchSize records at a time, like AddDocumentsFromReader.
+func AddDocumentsFromFile(ctx context.Context, index *meilisearch.Index, path string, batchSize int, primaryKey ...string) (resp []meilisearch.Task, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open Avro file")
+	}
+	defer f.Close()
+	return AddDocumentsFromReader(ctx, index, f, batchSize, primaryKey...)
+}
+
+// AddDocumentsFromReaderAt adds the records of the Avro file of size bytes
+// read from r to index, batchSize records at a time, like
+// AddDocumentsFromReader.
+func AddDocumentsFromReaderAt(ctx context.Context, index *meilisearch.Index, r io.ReaderAt, size int64, batchSize int, primaryKey ...string) (resp []meilisearch.Task, err error) {
+	return AddDocumentsFromReader(ctx, index, io.NewSectionReader(r, 0, size), batchSize, primaryKey...)
+}
+
+// AddDocumentsFromReader adds the records of the Avro object container file
+// read from r to index, batchSize records at a time.
+// Records are read and sent continuously to avoid reading the whole file into
+// memory, and the next batch is not read once ctx is done. Like
+// Index.AddDocumentsInBatches, a *meilisearch.BatchError holding the tasks
+// already enqueued is returned if a batch fails.
+func AddDocumentsFromReader(ctx context.Context, index *meilisearch.Index, r io.Reader, batchSize int, primaryKey ...string) (resp []meilisearch.Task, err error) {
+	if batchSize <= 0 {
+		return nil, errors.Errorf("invalid batch size %d, must be positive", batchSize)
+	}
+	ocf, err := goavro.NewOCFReader(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read Avro file")
+	}
+	var schema interface{}
+	if err := json.Unmarshal([]byte(ocf.Codec().Schema()), &schema); err != nil {
+		return nil, errors.Wrap(err, "could not decode Avro schema")
+	}
+	c := newConverter(schema)
+
+	var (
+		batch []interface{}
+		// Number of records read so far
+		count int
+	)
+
+	batchError := func(end int, err error) error {
+		return &meilisearch.BatchError{
+			Tasks:       resp,
+			BatchIndex:  len(resp),
+			Start:       len(resp) * batchSize,
+			End:         end,
+			StartOffset: -1,
+			EndOffset:   -1,
+			OriginError: err,
+		}
+	}
+
+	sendBatch := func() error {
+		if err := ctx.Err(); err != nil {
+			return batchError(count, err)
+		}
+		task, err := index.AddDocuments(batch, primaryKey...)
+		if err != nil {
+			return batchError(count, err)
+		}
+		resp = append(resp, *task)
+		batch = nil
+		return nil
+	}
+
+	for ocf.Scan() {
+		datum, err := ocf.Read()
+		if err != nil {
+			return nil, batchError(count+1, errors.Wrap(err, "could not read Avro record"))
+		}
+		document, err := c.convert(datum, schema)
+		if err != nil {
+			return nil, batchError(count+1, errors.Wrapf(err, "could not convert Avro record %d", count))
+		}
+		batch = append(batch, document)
+		count++
+
+		if len(batch) == batchSize {
+			if err := sendBatch(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := ocf.Err(); err != nil {
+		return nil, batchError(count+1, errors.Wrap(err, "could not read Avro file"))
+	}
+
+	// Send remaining records as the last batch if there is any
+	if len(batch) > 0 {
+		if err := sendBatch(); err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// converter turns the native Go values decoded by goavro into values encoded
+// as expected in a document, following the schema they were decoded with.
+type converter struct {
+	// Named types (records, enums and fixed) by full name
+	named map[string]map[string]interface{}
+}
+
+func newConverter(schema interface{}) *converter {
+	c := &converter{
+		named: map[string]map[string]interface{}{},
+	}
+	c.register(schema, "")
+	return c
+}
+
+// register records the named types defined in schema
+func (c *converter) register(schema interface{}, namespace string) {
+	switch s := schema.(type) {
+	case []interface{}:
+		for _, branch := range s {
+			c.register(branch, namespace)
+		}
+	case map[string]interface{}:
+		if name, ok := s["name"].(string); ok {
+			if ns, ok := s["namespace"].(string); ok {
+				namespace = ns
+			}
+			c.named[fullName(name, namespace)] = s
+			c.named[name] = s
+		}
+		switch s["type"] {
+		case "record", "error":
+			fields, _ := s["fields"].([]interface{})
+			for _, field := range fields {
+				if f, ok := field.(map[string]interface{}); ok {
+					c.register(f["type"], namespace)
+				}
+			}
+		case "array":
+			c.register(s["items"], namespace)
+		case "map":
+			c.register(s["values"], namespace)
+		default:
+			if _, ok := s["type"].(string); !ok {
+				c.register(s["type"], namespace)
+			}
+		}
+	}
+}
+
+func fullName(name string, namespace string) string {
+	if namespace == "" {
+		return name
+	}
+	for _, r := range name {
+		if r == '.' {
+			return name
+		}
+	}
+	return namespace + "." + name
+}
+
+// resolve returns the definition of a named type referenced by schema
+func (c *converter) resolve(schema interface{}) interface{} {
+	if name, ok := schema.(string); ok {
+		if definition, ok := c.named[name]; ok {
+			return definition
+		}
+	}
+	return schema
+}
+
+func (c *converter) convert(datum interface{}, schema interface{}) (interface{}, error) {
+	if datum == nil {
+		return nil, nil
+	}
+
+	switch s := c.resolve(schema).(type) {
+	case []interface{}:
+		// Unions are decoded as a map from the name of the branch to the value
+		branches, ok := datum.(map[string]interface{})
+		if !ok || len(branches) != 1 {
+			return nil, errors.Errorf("unexpected value %T for union", datum)
+		}
+		for name, value := range branches {
+			return c.convert(value, c.branch(s, name))
+		}
+	case map[string]interface{}:
+		switch s["type"] {
+		case "record", "error":
+			record, ok := datum.(map[string]interface{})
+			if !ok {
+				return nil, errors.Errorf("unexpected value %T for record", datum)
+			}
+			fields, _ := s["fields"].([]interface{})
+			document := make(map[string]interface{}, len(fields))
+			for _, field := range fields {
+				f, _ := field.(map[string]interface{})
+				name, _ := f["name"].(string)
+				value, err := c.convert(record[name], f["type"])
+				if err != nil {
+					return nil, errors.Wrapf(err, "field %s", name)
+				}
+				document[name] = value
+			}
+			return document, nil
+		case "array":
+			items, ok := datum.([]interface{})
+			if !ok {
+				return nil, errors.Errorf("unexpected value %T for array", datum)
+			}
+			values := make([]interface{}, len(items))
+			for j, item := range items {
+				value, err := c.convert(item, s["items"])
+				if err != nil {
+					return nil, err
+				}
+				values[j] = value
+			}
+			return values, nil
+		case "map":
+			entries, ok := datum.(map[string]interface{})
+			if !ok {
+				return nil, errors.Errorf("unexpected value %T for map", datum)
+			}
+			values := make(map[string]interface{}, len(entries))
+			for key, entry := range entries {
+				value, err := c.convert(entry, s["values"])
+				if err != nil {
+					return nil, err
+				}
+				values[key] = value
+			}
+			return values, nil
+		}
+	}
+
+	return convertPrimitive(datum, c.resolve(schema)), nil
+}
+
+// branch returns the schema of the branch of a union decoded under name
+func (c *converter) branch(union []interface{}, name string) interface{} {
+	for _, branch := range union {
+		if branchName(c.resolve(branch)) == name {
+			return branch
+		}
+	}
+	return name
+}
+
+// branchName returns the name goavro uses for a branch of a union
+func branchName(schema interface{}) string {
+	switch s := schema.(type) {
+	case string:
+		return s
+	case map[string]interface{}:
+		if name, ok := s["name"].(string); ok {
+			if ns, ok := s["namespace"].(string); ok {
+				return fullName(name, ns)
+			}
+			return name
+		}
+		if logicalType, ok := s["logicalType"].(string); ok {
+			if t, ok := s["type"].(string); ok {
+				return t + "." + logicalType
+			}
+		}
+		if t, ok := s["type"].(string); ok {
+			return t
+		}
+	}
+	return ""
+}
+
+func convertPrimitive(datum interface{}, schema interface{}) interface{} {
+	switch value := datum.(type) {
+	case *big.Rat:
+		// Decimals are kept exact, with the scale of their schema
+		return json.Number(value.FloatString(decimalScale(schema)))
+	case []byte:
+		return string(value)
+	}
+	return datum
+}
+
+// decimalScale returns the scale of a decimal logical type, 0 if the schema
+// does not give one
+func decimalScale(schema interface{}) int {
+	s, _ := schema.(map[string]interface{})
+	scale, _ := s["scale"].(float64)
+	return int(scale)
+}
@@ -0,0 +1,257 @@
+package avro
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/meilisearch/meilisearch-go"
+	"github.com/stretchr/testify/require"
+)
+
+const testSchema = `{
+	"type": "record",
+	"name": "Book",
+	"namespace": "test",
+	"fields": [
+		{"name": "id", "type": "long"},
+		{"name": "title", "type": "string"},
+		{"name": "subtitle", "type": ["null", "string"], "default": null},
+		{"name": "price", "type": {"type": "bytes", "logicalType": "decimal", "precision": 6, "scale": 2}},
+		{"name": "published_at", "type": ["null", {"type": "long", "logicalType": "timestamp-millis"}], "default": null},
+		{"name": "tags", "type": {"type": "array", "items": "string"}},
+		{"name": "ratings", "type": {"type": "map", "values": "int"}},
+		{"name": "author", "type": ["null", {
+			"type": "record",
+			"name": "Author",
+			"fields": [
+				{"name": "name", "type": "string"},
+				{"name": "country", "type": {"type": "enum", "name": "Country", "symbols": ["UK", "FR"]}}
+			]
+		}], "default": null}
+	]
+}`
+
+func testAvroFile(t *testing.T) []byte {
+	codec, err := goavro.NewCodec(testSchema)
+	require.NoError(t, err)
+
+	b := new(bytes.Buffer)
+	w, err := goavro.NewOCFWriter(goavro.OCFConfig{W: b, Codec: codec})
+	require.NoError(t, err)
+	err = w.Append([]interface{}{
+		map[string]interface{}{
+			"id":           int64(1),
+			"title":        "Alice In Wonderland",
+			"subtitle":     goavro.Union("string", "Through the Looking-Glass"),
+			"price":        big.NewRat(1250, 100),
+			"published_at": goavro.Union("long.timestamp-millis", time.Date(1865, 11, 26, 0, 0, 0, 0, time.UTC)),
+			"tags":         []interface{}{"tale", "classic"},
+			"ratings":      map[string]interface{}{"goodreads": int32(4)},
+			"author":       goavro.Union("test.Author", map[string]interface{}{"name": "Lewis Carroll", "country": "UK"}),
+		},
+		map[string]interface{}{
+			"id":           int64(2),
+			"title":        "Le Petit Prince",
+			"subtitle":     nil,
+			"price":        big.NewRat(8, 1),
+			"published_at": nil,
+			"tags":         []interface{}{},
+			"ratings":      map[string]interface{}{},
+			"author":       nil,
+		},
+		map[string]interface{}{
+			"id":           int64(3),
+			"title":        "Pride and Prejudice",
+			"subtitle":     nil,
+			"price":        big.NewRat(5, 1),
+			"published_at": nil,
+			"tags":         []interface{}{"romance"},
+			"ratings":      map[string]interface{}{},
+			"author":       nil,
+		},
+	})
+	require.NoError(t, err)
+	return b.Bytes()
+}
+
+var testWantDocuments = []map[string]interface{}{
+	{
+		"id":           float64(1),
+		"title":        "Alice In Wonderland",
+		"subtitle":     "Through the Looking-Glass",
+		"price":        12.5,
+		"published_at": "1865-11-26T00:00:00Z",
+		"tags":         []interface{}{"tale", "classic"},
+		"ratings":      map[string]interface{}{"goodreads": float64(4)},
+		"author":       map[string]interface{}{"name": "Lewis Carroll", "country": "UK"},
+	},
+	{
+		"id":           float64(2),
+		"title":        "Le Petit Prince",
+		"subtitle":     nil,
+		"price":        float64(8),
+		"published_at": nil,
+		"tags":         []interface{}{},
+		"ratings":      map[string]interface{}{},
+		"author":       nil,
+	},
+	{
+		"id":           float64(3),
+		"title":        "Pride and Prejudice",
+		"subtitle":     nil,
+		"price":        float64(5),
+		"published_at": nil,
+		"tags":         []interface{}{"romance"},
+		"ratings":      map[string]interface{}{},
+		"author":       nil,
+	},
+}
+
+func TestConverter(t *testing.T) {
+	ocf, err := goavro.NewOCFReader(bytes.NewReader(testAvroFile(t)))
+	require.NoError(t, err)
+	var schema interface{}
+	require.NoError(t, json.Unmarshal([]byte(ocf.Codec().Schema()), &schema))
+	c := newConverter(schema)
+
+	var documents []map[string]interface{}
+	for ocf.Scan() {
+		datum, err := ocf.Read()
+		require.NoError(t, err)
+		document, err := c.convert(datum, schema)
+		require.NoError(t, err)
+
+		// Compare the documents as Meilisearch would receive them
+		data, err := json.Marshal(document)
+		require.NoError(t, err)
+		var decoded map[string]interface{}
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		documents = append(documents, decoded)
+	}
+	require.NoError(t, ocf.Err())
+	require.Equal(t, testWantDocuments, documents)
+}
+
+func TestConverterDecimal(t *testing.T) {
+	const decimalSchema = `{
+		"type": "record",
+		"name": "Price",
+		"fields": [
+			{"name": "bytes", "type": {"type": "bytes", "logicalType": "decimal", "precision": 6, "scale": 2}},
+			{"name": "fixed", "type": {"type": "fixed", "name": "Amount", "size": 8, "logicalType": "decimal", "precision": 10, "scale": 3}},
+			{"name": "optional", "type": ["null", {"type": "bytes", "logicalType": "decimal", "precision": 6, "scale": 2}]},
+			{"name": "integer", "type": {"type": "bytes", "logicalType": "decimal", "precision": 6, "scale": 0}}
+		]
+	}`
+	tests := []struct {
+		name  string
+		value *big.Rat
+		want  map[string]interface{}
+	}{
+		{
+			name:  "TestConverterDecimalTwoPlaces",
+			value: big.NewRat(1225, 100),
+			want: map[string]interface{}{
+				"bytes":    json.Number("12.25"),
+				"fixed":    json.Number("12.250"),
+				"optional": json.Number("12.25"),
+				"integer":  json.Number("12"),
+			},
+		},
+		{
+			name:  "TestConverterDecimalReduced",
+			value: big.NewRat(25, 100),
+			want: map[string]interface{}{
+				"bytes":    json.Number("0.25"),
+				"fixed":    json.Number("0.250"),
+				"optional": json.Number("0.25"),
+				"integer":  json.Number("0"),
+			},
+		},
+		{
+			name:  "TestConverterDecimalInteger",
+			value: big.NewRat(8, 1),
+			want: map[string]interface{}{
+				"bytes":    json.Number("8.00"),
+				"fixed":    json.Number("8.000"),
+				"optional": json.Number("8.00"),
+				"integer":  json.Number("8"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			codec, err := goavro.NewCodec(decimalSchema)
+			require.NoError(t, err)
+			integer := tt.value
+			if !integer.IsInt() {
+				// Without scale, only the integer part is encoded
+				integer = new(big.Rat).SetInt(new(big.Int).Quo(tt.value.Num(), tt.value.Denom()))
+			}
+			binary, err := codec.BinaryFromNative(nil, map[string]interface{}{
+				"bytes":    tt.value,
+				"fixed":    tt.value,
+				"optional": goavro.Union("bytes.decimal", tt.value),
+				"integer":  integer,
+			})
+			require.NoError(t, err)
+			datum, _, err := codec.NativeFromBinary(binary)
+			require.NoError(t, err)
+
+			var schema interface{}
+			require.NoError(t, json.Unmarshal([]byte(codec.Schema()), &schema))
+			document, err := newConverter(schema).convert(datum, schema)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, document)
+		})
+	}
+}
+
+func TestAddDocumentsFromFile(t *testing.T) {
+	client := meilisearch.NewClient(meilisearch.ClientConfig{
+		Host:   "http://localhost:7700",
+		APIKey: "masterKey",
+	})
+	index := client.Index("TestAvroAddDocumentsFromFile")
+	t.Cleanup(func() {
+		task, _ := client.DeleteIndex(index.UID)
+		if task != nil {
+			_, _ = client.WaitForTask(task)
+		}
+	})
+
+	path := filepath.Join(t.TempDir(), "books.avro")
+	require.NoError(t, os.WriteFile(path, testAvroFile(t), 0600))
+
+	gotResp, err := AddDocumentsFromFile(context.Background(), index, path, 2, "id")
+	require.NoError(t, err)
+	require.Len(t, gotResp, 2)
+	for _, task := range gotResp {
+		finalTask, err := index.WaitForTask(&task)
+		require.NoError(t, err)
+		require.Equal(t, meilisearch.TaskStatusSucceeded, finalTask.Status)
+	}
+
+	var documents []map[string]interface{}
+	err = index.GetDocuments(&meilisearch.DocumentsRequest{}, &documents)
+	require.NoError(t, err)
+	require.Equal(t, testWantDocuments, documents)
+}
+
+func TestAddDocumentsFromReaderInvalidBatchSize(t *testing.T) {
+	client := meilisearch.NewClient(meilisearch.ClientConfig{
+		Host:   "http://localhost:7700",
+		APIKey: "masterKey",
+	})
+	index := client.Index("TestAvroAddDocumentsFromReaderInvalidBatchSize")
+
+	_, err := AddDocumentsFromReader(context.Background(), index, bytes.NewReader(testAvroFile(t)), 0, "id")
+	require.EqualError(t, err, "invalid batch size 0, must be positive")
+}
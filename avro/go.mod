@@ -0,0 +1,25 @@
+module github.com/meilisearch/meilisearch-go/avro
+
+go 1.18
+
+require (
+	github.com/linkedin/goavro/v2 v2.11.1
+	github.com/meilisearch/meilisearch-go v0.0.0-20261018220431-47be722fc501
+	github.com/pkg/errors v0.9.1
+	github.com/stretchr/testify v1.7.0
+)
+
+require (
+	github.com/andybalholm/brotli v1.0.4 // indirect
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/golang/snappy v0.0.3 // indirect
+	github.com/josharian/intern v1.0.0 // indirect
+	github.com/klauspost/compress v1.14.1 // indirect
+	github.com/kr/text v0.2.0 // indirect
+	github.com/mailru/easyjson v0.7.7 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/valyala/bytebufferpool v1.0.0 // indirect
+	github.com/valyala/fasthttp v1.33.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
@@ -0,0 +1,77 @@
+// Package boltstore provides a meilisearch.DocumentHashStore backed by a
+// BoltDB file, for synchronizing indexes too large to keep their document
+// hashes in a JSON file.
+package boltstore
+
+import (
+	"github.com/meilisearch/meilisearch-go"
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+)
+
+var defaultBucket = []byte("meilisearch_document_hashes")
+
+// DocumentHashStore is a meilisearch.DocumentHashStore keeping the hashes in
+// a bucket of a BoltDB database.
+type DocumentHashStore struct {
+	db     *bolt.DB
+	bucket []byte
+}
+
+var _ meilisearch.DocumentHashStore = &DocumentHashStore{}
+
+// New creates a DocumentHashStore keeping the hashes in the bucket of db, or
+// in a default bucket if bucket is empty. Use a different bucket for each
+// synchronized index.
+func New(db *bolt.DB, bucket string) *DocumentHashStore {
+	s := &DocumentHashStore{
+		db:     db,
+		bucket: defaultBucket,
+	}
+	if bucket != "" {
+		s.bucket = []byte(bucket)
+	}
+	return s
+}
+
+func (s *DocumentHashStore) Hashes() (map[string]string, error) {
+	hashes := map[string]string{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(s.bucket)
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			hashes[string(k)] = string(v)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read document hashes")
+	}
+	return hashes, nil
+}
+
+func (s *DocumentHashStore) Update(changed map[string]string, deleted []string) error {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(s.bucket)
+		if err != nil {
+			return err
+		}
+		for id, hash := range changed {
+			if err := b.Put([]byte(id), []byte(hash)); err != nil {
+				return err
+			}
+		}
+		for _, id := range deleted {
+			if err := b.Delete([]byte(id)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not write document hashes")
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package boltstore
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestDocumentHashStore(t *testing.T) {
+	db, err := bolt.Open(filepath.Join(t.TempDir(), "hashes.db"), 0600, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	store := New(db, "")
+	other := New(db, "other")
+
+	hashes, err := store.Hashes()
+	require.NoError(t, err)
+	require.Empty(t, hashes)
+
+	require.NoError(t, store.Update(map[string]string{"1": "a", "2": "b"}, nil))
+	require.NoError(t, other.Update(map[string]string{"3": "c"}, nil))
+	require.NoError(t, store.Update(map[string]string{"2": "d"}, []string{"1", "4"}))
+
+	hashes, err = store.Hashes()
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"2": "d"}, hashes)
+
+	hashes, err = other.Hashes()
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"3": "c"}, hashes)
+}
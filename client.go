@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/valyala/fasthttp"
 )
 
@@ -21,6 +22,21 @@ type ClientConfig struct {
 
 	// Timeout is optional
 	Timeout time.Duration
+
+	// ValidateDocuments is optional, when set documents are checked by
+	// ValidateDocuments before being added or updated, and invalid documents
+	// make the call fail before anything is sent
+	ValidateDocuments bool
+
+	// InferPrimaryKey is optional, when set documents added as JSON without
+	// primary key to an Index without PrimaryKey are sent with the primary key
+	// returned by InferPrimaryKey, and documents it cannot infer one from make
+	// the call fail before anything is sent
+	InferPrimaryKey bool
+
+	// AliasStore is optional, it stores the aliases resolved by Client.Alias,
+	// a MemoryAliasStore is used if nil
+	AliasStore AliasStore
 }
 
 type WaitParams struct {
@@ -61,6 +77,7 @@ func NewFastHTTPCustomClient(config ClientConfig, client *fasthttp.Client) *Clie
 	c := &Client{
 		config:     config,
 		httpClient: client,
+		aliases:    config.AliasStore,
 	}
 	return c
 }
@@ -73,6 +90,7 @@ func NewClient(config ClientConfig) *Client {
 	c := &Client{
 		config:     config,
 		httpClient: client,
+		aliases:    config.AliasStore,
 	}
 	return c
 }
@@ -284,6 +302,27 @@ func (c *Client) GetTasks() (resp *ResultTask, err error) {
 	return resp, nil
 }
 
+// SwapIndexes swaps the documents, settings and task history of each pair of
+// indexes of params in a single atomic task. Both indexes of a pair must
+// exist. It requires Meilisearch v0.30 or later, an *UnsupportedError is
+// returned by older servers.
+func (c *Client) SwapIndexes(params []SwapIndexesParams) (resp *Task, err error) {
+	resp = &Task{}
+	req := internalRequest{
+		endpoint:            "/swap-indexes",
+		method:              http.MethodPost,
+		contentType:         contentTypeJSON,
+		withRequest:         params,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "SwapIndexes",
+	}
+	if err := c.executeRequest(req); err != nil {
+		return nil, unsupportedError(err, "SwapIndexes", "v0.30")
+	}
+	return resp, nil
+}
+
 // WaitForTask waits for a task to be processed.
 // The function will check by regular interval provided in parameter interval
 // the TaskStatus.
@@ -315,6 +354,23 @@ func (c *Client) WaitForTask(task *Task, options ...WaitParams) (*Task, error) {
 	}
 }
 
+// waitForTaskSucceeded waits for a task to be processed, checking its status
+// each 50ms until ctx is done, and returns an error if the task did not
+// succeed.
+func (c *Client) waitForTaskSucceeded(ctx context.Context, task *Task) (*Task, error) {
+	finalTask, err := c.WaitForTask(task, WaitParams{
+		Context:  ctx,
+		Interval: time.Millisecond * 50,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if finalTask.Status != TaskStatusSucceeded {
+		return finalTask, errors.Errorf("task %d %s: %s", finalTask.UID, finalTask.Status, finalTask.Error.Message)
+	}
+	return finalTask, nil
+}
+
 // This function allows the user to create a Key with an ExpiredAt in time.Time
 // and transform the Key structure into a KeyParsed structure to send the time format
 // managed by Meilisearch
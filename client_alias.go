@@ -0,0 +1,185 @@
+package meilisearch
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// AliasStore stores the aliases of a Client, each one pointing to the uid of
+// an index.
+type AliasStore interface {
+	// Get returns the uid the alias points to, or "" if the alias is not set
+	Get(alias string) (string, error)
+	// Set points the alias to uid, replacing its previous uid atomically
+	Set(alias string, uid string) error
+	// Delete removes the alias, deleting an alias that is not set is not an
+	// error
+	Delete(alias string) error
+}
+
+// MemoryAliasStore is an AliasStore keeping the aliases in memory, they are
+// only shared by the clients using the same store.
+type MemoryAliasStore struct {
+	mu      sync.RWMutex
+	aliases map[string]string
+}
+
+var _ AliasStore = &MemoryAliasStore{}
+
+// NewMemoryAliasStore creates an empty MemoryAliasStore
+func NewMemoryAliasStore() *MemoryAliasStore {
+	return &MemoryAliasStore{
+		aliases: map[string]string{},
+	}
+}
+
+func (s *MemoryAliasStore) Get(alias string) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.aliases[alias], nil
+}
+
+func (s *MemoryAliasStore) Set(alias string, uid string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.aliases[alias] = uid
+	return nil
+}
+
+func (s *MemoryAliasStore) Delete(alias string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.aliases, alias)
+	return nil
+}
+
+// IndexAliasStore is an AliasStore keeping the aliases as documents of a
+// Meilisearch index, so that they are shared by every client of the instance.
+// The index is created with the first alias. Alias names must be valid
+// document identifiers.
+type IndexAliasStore struct {
+	Client *Client
+	UID    string
+}
+
+var _ AliasStore = &IndexAliasStore{}
+
+// aliasDocument is the document storing an alias in an IndexAliasStore
+type aliasDocument struct {
+	Alias string `json:"alias"`
+	UID   string `json:"uid"`
+}
+
+// NewIndexAliasStore creates an IndexAliasStore keeping the aliases in the
+// index uid of client
+func NewIndexAliasStore(client *Client, uid string) *IndexAliasStore {
+	return &IndexAliasStore{
+		Client: client,
+		UID:    uid,
+	}
+}
+
+func (s *IndexAliasStore) Get(alias string) (string, error) {
+	var document aliasDocument
+	err := s.Client.Index(s.UID).GetDocument(alias, &document)
+	if errors.Is(err, ErrDocumentNotFound) || errors.Is(err, ErrIndexNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return document.UID, nil
+}
+
+func (s *IndexAliasStore) Set(alias string, uid string) error {
+	task, err := s.Client.Index(s.UID).AddDocuments([]aliasDocument{{Alias: alias, UID: uid}}, "alias")
+	if err != nil {
+		return err
+	}
+	if _, err := s.wait(task); err != nil {
+		return errors.Wrapf(err, "could not set alias %q", alias)
+	}
+	return nil
+}
+
+func (s *IndexAliasStore) Delete(alias string) error {
+	task, err := s.Client.Index(s.UID).DeleteDocument(alias)
+	if errors.Is(err, ErrIndexNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if finalTask, err := s.wait(task); err != nil {
+		if finalTask != nil && finalTask.Error.Code == "index_not_found" {
+			return nil
+		}
+		return errors.Wrapf(err, "could not delete alias %q", alias)
+	}
+	return nil
+}
+
+// wait waits for task with the default timeout of Client.WaitForTask and
+// returns an error if it did not succeed
+func (s *IndexAliasStore) wait(task *Task) (*Task, error) {
+	finalTask, err := s.Client.WaitForTask(task)
+	if err != nil {
+		return nil, err
+	}
+	if finalTask.Status != TaskStatusSucceeded {
+		return finalTask, errors.Errorf("task %d %s: %s", finalTask.UID, finalTask.Status, finalTask.Error.Message)
+	}
+	return finalTask, nil
+}
+
+// Alias returns the index the alias currently points to, resolved with the
+// AliasStore of the client on each call. An error matching ErrAliasNotFound
+// is returned if the alias is not set.
+// Pointing an alias to a new index with SetAlias switches every caller of
+// Alias at once, for instance once a new version of an index is built:
+//
+//	index, err := client.Alias("products")
+//	...
+//	err = client.SetAlias("products", "products_20261017")
+func (c *Client) Alias(alias string) (*Index, error) {
+	uid, err := c.aliasStore().Get(alias)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not resolve alias %q", alias)
+	}
+	if uid == "" {
+		return nil, errors.Wrapf(ErrAliasNotFound, "could not resolve alias %q", alias)
+	}
+	return c.Index(uid), nil
+}
+
+// SetAlias points the alias to the index uid, which must exist.
+func (c *Client) SetAlias(alias string, uid string) error {
+	if _, err := c.GetIndex(uid); err != nil {
+		return errors.Wrapf(err, "could not point alias %q to %q", alias, uid)
+	}
+	return c.aliasStore().Set(alias, uid)
+}
+
+// DeleteAlias removes the alias, the index it points to is left untouched.
+func (c *Client) DeleteAlias(alias string) error {
+	return c.aliasStore().Delete(alias)
+}
+
+// aliasesMu guards the alias store of clients created without constructor,
+// which is created on first use
+var aliasesMu sync.Mutex
+
+// aliasStore returns the alias store of c, ClientConfig.AliasStore or a
+// MemoryAliasStore if nil
+func (c *Client) aliasStore() AliasStore {
+	aliasesMu.Lock()
+	defer aliasesMu.Unlock()
+	if c.aliases == nil {
+		c.aliases = c.config.AliasStore
+		if c.aliases == nil {
+			c.aliases = NewMemoryAliasStore()
+		}
+	}
+	return c.aliases
+}
@@ -0,0 +1,114 @@
+package meilisearch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+func TestClient_Alias(t *testing.T) {
+	t.Cleanup(cleanup(defaultClient))
+
+	blue := defaultClient.Index("TestClientAliasBlue")
+	task, err := blue.AddDocuments([]docTest{{ID: "1", Name: "Alice In Wonderland"}}, "id")
+	require.NoError(t, err)
+	testWaitForTask(t, blue, task)
+	green := defaultClient.Index("TestClientAliasGreen")
+	task, err = green.AddDocuments([]docTest{{ID: "2", Name: "Le Petit Prince"}}, "id")
+	require.NoError(t, err)
+	testWaitForTask(t, green, task)
+
+	tests := []struct {
+		name  string
+		store AliasStore
+	}{
+		{
+			name:  "TestClientAliasMemoryStore",
+			store: NewMemoryAliasStore(),
+		},
+		{
+			name:  "TestClientAliasIndexStore",
+			store: NewIndexAliasStore(defaultClient, "TestClientAliasIndexStore"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(ClientConfig{
+				Host:       "http://localhost:7700",
+				APIKey:     masterKey,
+				AliasStore: tt.store,
+			})
+
+			_, err := c.Alias("products")
+			require.ErrorIs(t, err, ErrAliasNotFound)
+			require.Contains(t, err.Error(), `could not resolve alias "products"`)
+
+			require.NoError(t, c.SetAlias("products", blue.UID))
+			index, err := c.Alias("products")
+			require.NoError(t, err)
+			require.Equal(t, blue.UID, index.UID)
+
+			// Repointing the alias is seen by every client sharing the store
+			other := NewClient(ClientConfig{
+				Host:       "http://localhost:7700",
+				APIKey:     masterKey,
+				AliasStore: tt.store,
+			})
+			require.NoError(t, other.SetAlias("products", green.UID))
+			index, err = c.Alias("products")
+			require.NoError(t, err)
+			require.Equal(t, green.UID, index.UID)
+			var document docTest
+			require.NoError(t, index.GetDocument("2", &document))
+			require.Equal(t, "Le Petit Prince", document.Name)
+
+			err = c.SetAlias("products", "TestClientAliasMissing")
+			require.ErrorIs(t, err, ErrIndexNotFound)
+			index, err = c.Alias("products")
+			require.NoError(t, err)
+			require.Equal(t, green.UID, index.UID)
+
+			require.NoError(t, c.DeleteAlias("products"))
+			_, err = c.Alias("products")
+			require.ErrorIs(t, err, ErrAliasNotFound)
+			require.NoError(t, c.DeleteAlias("products"))
+		})
+	}
+
+	t.Run("TestClientAliasDefaultStore", func(t *testing.T) {
+		c := NewClient(ClientConfig{Host: "http://localhost:7700", APIKey: masterKey})
+		require.NoError(t, c.SetAlias("products", blue.UID))
+		index, err := c.Alias("products")
+		require.NoError(t, err)
+		require.Equal(t, blue.UID, index.UID)
+
+		// Clients do not share the default store
+		_, err = defaultClient.Alias("products")
+		require.ErrorIs(t, err, ErrAliasNotFound)
+	})
+
+	t.Run("TestClientAliasWithoutConstructor", func(t *testing.T) {
+		c := &Client{
+			config: ClientConfig{Host: "http://localhost:7700", APIKey: masterKey},
+			httpClient: &fasthttp.Client{
+				Name: "meilsearch-client",
+			},
+		}
+		_, err := c.Alias("products")
+		require.ErrorIs(t, err, ErrAliasNotFound)
+		require.NoError(t, c.SetAlias("products", blue.UID))
+		index, err := c.Alias("products")
+		require.NoError(t, err)
+		require.Equal(t, blue.UID, index.UID)
+		require.NoError(t, c.DeleteAlias("products"))
+	})
+
+	t.Run("TestClientAliasIndexStoreMissingIndex", func(t *testing.T) {
+		store := NewIndexAliasStore(defaultClient, "TestClientAliasIndexStoreMissingIndex")
+		uid, err := store.Get("products")
+		require.NoError(t, err)
+		require.Empty(t, uid)
+		require.NoError(t, store.Delete("products"))
+	})
+}
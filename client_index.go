@@ -1,7 +1,10 @@
 package meilisearch
 
 import (
+	"context"
 	"net/http"
+
+	"github.com/pkg/errors"
 )
 
 func (c *Client) Index(uid string) *Index {
@@ -49,6 +52,56 @@ func (c *Client) CreateIndex(config *IndexConfig) (resp *Task, err error) {
 	return resp, nil
 }
 
+// EnsureIndex makes sure the index uid exists with primaryKey and settings,
+// and can be called again with the same arguments without side effects: the
+// index is created if missing, its primary key is set if it has none yet and
+// an error is returned if it has another one. The current settings of the
+// index are then compared to settings by DiffSettings and only the settings
+// that differ are updated, each with its own endpoint. settings may be nil.
+// EnsureIndex returns once every task succeeded.
+func (c *Client) EnsureIndex(ctx context.Context, uid string, primaryKey string, settings *Settings) (*Index, error) {
+	index, err := c.GetIndex(uid)
+	if errors.Is(err, ErrIndexNotFound) {
+		task, err := c.CreateIndex(&IndexConfig{Uid: uid, PrimaryKey: primaryKey})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := c.waitForTaskSucceeded(ctx, task); err != nil {
+			return nil, errors.Wrapf(err, "could not create index %q", uid)
+		}
+		index = c.Index(uid)
+		index.PrimaryKey = primaryKey
+	} else if err != nil {
+		return nil, err
+	}
+
+	if primaryKey != "" && index.PrimaryKey != primaryKey {
+		if index.PrimaryKey != "" {
+			return nil, errors.Errorf("index %q has primary key %q instead of %q", uid, index.PrimaryKey, primaryKey)
+		}
+		task, err := index.UpdateIndex(primaryKey)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := c.waitForTaskSucceeded(ctx, task); err != nil {
+			return nil, errors.Wrapf(err, "could not set primary key of index %q", uid)
+		}
+		index.PrimaryKey = primaryKey
+	}
+	if settings == nil {
+		return index, nil
+	}
+
+	plan, err := index.PlanSettings(settings)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := plan.Apply(ctx, index); err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
 func (c *Client) GetAllIndexes() (resp []*Index, err error) {
 	resp = []*Index{}
 	req := internalRequest{
@@ -1,6 +1,7 @@
 package meilisearch
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -647,3 +648,78 @@ func TestClient_Index(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_EnsureIndex(t *testing.T) {
+	c := defaultClient
+	t.Cleanup(cleanup(c))
+
+	distinct := "isbn"
+	desired := &Settings{
+		DistinctAttribute:    &distinct,
+		SearchableAttributes: []string{"title", "author"},
+		StopWords:            []string{"the", "a"},
+		Synonyms:             map[string][]string{"tale": {"story", "fable"}},
+		FilterableAttributes: []string{"genre", "year"},
+	}
+
+	t.Run("TestClientEnsureIndexCreate", func(t *testing.T) {
+		index, err := c.EnsureIndex(context.Background(), "TestClientEnsureIndexCreate", "id", desired)
+		require.NoError(t, err)
+		require.Equal(t, "id", index.PrimaryKey)
+
+		gotIndex, err := c.GetIndex("TestClientEnsureIndexCreate")
+		require.NoError(t, err)
+		require.Equal(t, "id", gotIndex.PrimaryKey)
+
+		gotSettings, err := index.GetSettings()
+		require.NoError(t, err)
+		require.Equal(t, &distinct, gotSettings.DistinctAttribute)
+		require.Equal(t, desired.SearchableAttributes, gotSettings.SearchableAttributes)
+		require.ElementsMatch(t, desired.StopWords, gotSettings.StopWords)
+		require.ElementsMatch(t, desired.FilterableAttributes, gotSettings.FilterableAttributes)
+		require.Equal(t, defaultRankingRules, gotSettings.RankingRules)
+
+		// Nothing is left to update, ensuring the index again is a no-op
+		require.True(t, DiffSettings(gotSettings, desired).IsEmpty())
+		_, err = c.EnsureIndex(context.Background(), "TestClientEnsureIndexCreate", "id", desired)
+		require.NoError(t, err)
+	})
+
+	t.Run("TestClientEnsureIndexReconcile", func(t *testing.T) {
+		i := c.Index("TestClientEnsureIndexReconcile")
+		task, err := i.UpdateSettings(&Settings{
+			RankingRules:         []string{"sort", "words"},
+			StopWords:            []string{"a", "the"},
+			SortableAttributes:   []string{"year"},
+			FilterableAttributes: []string{"tag"},
+		})
+		require.NoError(t, err)
+		testWaitForTask(t, i, task)
+
+		index, err := c.EnsureIndex(context.Background(), i.UID, "id", &Settings{
+			RankingRules:         []string{},
+			StopWords:            []string{"the", "a"},
+			FilterableAttributes: []string{"genre"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "id", index.PrimaryKey)
+
+		gotSettings, err := index.GetSettings()
+		require.NoError(t, err)
+		require.Equal(t, defaultRankingRules, gotSettings.RankingRules)
+		require.Equal(t, []string{"a", "the"}, gotSettings.StopWords)
+		require.Equal(t, []string{"genre"}, gotSettings.FilterableAttributes)
+		// Settings left nil are untouched
+		require.Equal(t, []string{"year"}, gotSettings.SortableAttributes)
+	})
+
+	t.Run("TestClientEnsureIndexOtherPrimaryKey", func(t *testing.T) {
+		task, err := c.CreateIndex(&IndexConfig{Uid: "TestClientEnsureIndexOtherPrimaryKey", PrimaryKey: "uid"})
+		require.NoError(t, err)
+		testWaitForTask(t, c.Index("TestClientEnsureIndexOtherPrimaryKey"), task)
+
+		_, err = c.EnsureIndex(context.Background(), "TestClientEnsureIndexOtherPrimaryKey", "id", nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `index "TestClientEnsureIndexOtherPrimaryKey" has primary key "uid" instead of "id"`)
+	})
+}
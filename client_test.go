@@ -891,3 +891,41 @@ func TestClient_WaitForTaskWithContext(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_SwapIndexes(t *testing.T) {
+	c := defaultClient
+	t.Cleanup(cleanup(c))
+
+	first := c.Index("TestClientSwapIndexesFirst")
+	task, err := first.AddDocuments([]docTest{{ID: "1", Name: "Alice In Wonderland"}}, "id")
+	require.NoError(t, err)
+	testWaitForTask(t, first, task)
+	second := c.Index("TestClientSwapIndexesSecond")
+	task, err = second.AddDocuments([]docTest{{ID: "2", Name: "Le Petit Prince"}, {ID: "3", Name: "Hamlet"}}, "id")
+	require.NoError(t, err)
+	testWaitForTask(t, second, task)
+
+	task, err = c.SwapIndexes([]SwapIndexesParams{{Indexes: []string{first.UID, second.UID}}})
+	require.NoError(t, err)
+	finalTask, err := c.WaitForTask(task)
+	require.NoError(t, err)
+	require.Equal(t, TaskStatusSucceeded, finalTask.Status)
+
+	stats, err := first.GetStats()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), stats.NumberOfDocuments)
+	stats, err = second.GetStats()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stats.NumberOfDocuments)
+
+	// No route is known below an unknown path prefix, like the swap route by
+	// servers older than v0.30
+	old := NewClient(ClientConfig{
+		Host:   "http://localhost:7700/unknown",
+		APIKey: masterKey,
+	})
+	task, err = old.SwapIndexes([]SwapIndexesParams{{Indexes: []string{first.UID, second.UID}}})
+	require.Nil(t, task)
+	require.ErrorIs(t, err, ErrUnsupportedByServer)
+	require.Contains(t, err.Error(), "SwapIndexes is unsupported by this server, it requires Meilisearch v0.30 or later")
+}
@@ -3,6 +3,7 @@ package meilisearch
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -102,6 +103,35 @@ type Error struct {
 	ErrCode ErrCode
 }
 
+var (
+	// ErrDocumentNotFound matches with errors.Is the errors returned by
+	// Meilisearch for a missing document
+	ErrDocumentNotFound = errors.New("document not found")
+	// ErrIndexNotFound matches with errors.Is the errors returned by
+	// Meilisearch for a missing index
+	ErrIndexNotFound = errors.New("index not found")
+	// ErrAliasNotFound is returned by Client.Alias for an alias that is not
+	// set
+	ErrAliasNotFound = errors.New("alias not found")
+	// ErrUnsupportedByServer matches with errors.Is the *UnsupportedError
+	// returned by methods relying on a route the server does not have
+	ErrUnsupportedByServer = errors.New("unsupported by this server")
+)
+
+// Is reports whether e is the error returned by Meilisearch for a missing
+// document or index, to let errors.Is match ErrDocumentNotFound and
+// ErrIndexNotFound.
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrDocumentNotFound:
+		return e.MeilisearchApiError.Code == "document_not_found"
+	case ErrIndexNotFound:
+		return e.MeilisearchApiError.Code == "index_not_found"
+	default:
+		return false
+	}
+}
+
 // Error return a well human formatted message.
 func (e Error) Error() string {
 	message := namedSprintf(e.rawMessage, map[string]interface{}{
@@ -154,3 +184,212 @@ func namedSprintf(format string, params map[string]interface{}) string {
 	}
 	return format
 }
+
+// BatchError is returned by the batch helpers (AddDocumentsInBatches,
+// AddDocumentsCsvFromReaderInBatches, ...) when a batch could not be read or
+// enqueued. Batches are sent one after the other, so the batches preceding the
+// failing one are already enqueued: their tasks are kept in Tasks to let the
+// caller wait for them, resume after them or roll them back.
+type BatchError struct {
+	// Tasks are the tasks of the batches enqueued before the failure, in order
+	Tasks []Task
+
+	// BatchIndex is the index of the failing batch
+	BatchIndex int
+
+	// Start and End delimit the records of the failing batch in the input
+	// (End excluded): document indexes for slices, data records (the header
+	// is not counted) for CSV and non-empty lines for NDJSON
+	Start int
+	End   int
+
+	// StartOffset and EndOffset delimit the failing batch in the input in
+	// bytes (EndOffset excluded). They are only known for NDJSON input and
+	// are set to -1 otherwise.
+	StartOffset int64
+	EndOffset   int64
+
+	// OriginError is the error that made the batch fail
+	OriginError error
+}
+
+// Error return a well human formatted message.
+func (e *BatchError) Error() string {
+	message := fmt.Sprintf("batch %d (records %d to %d) failed, %d batches enqueued before it",
+		e.BatchIndex, e.Start, e.End, len(e.Tasks))
+	if e.OriginError != nil {
+		return errors.Wrap(e.OriginError, message).Error()
+	}
+	return message
+}
+
+// Unwrap returns the error that made the batch fail
+func (e *BatchError) Unwrap() error {
+	return e.OriginError
+}
+
+// CsvRowError describes a malformed row of a CSV input
+type CsvRowError struct {
+	// Record is the index of the row among the data records of the input
+	// (the header is not counted)
+	Record int
+
+	// Line is the line of the input the error was found on
+	Line int
+
+	// Err is the reason the row is malformed
+	Err error
+}
+
+// Error return a well human formatted message.
+func (e *CsvRowError) Error() string {
+	return fmt.Sprintf("CSV record %d (line %d): %v", e.Record, e.Line, e.Err)
+}
+
+// Unwrap returns the reason the row is malformed
+func (e *CsvRowError) Unwrap() error {
+	return e.Err
+}
+
+// DocumentError locates an invalid document
+type DocumentError struct {
+	// Record is the index of the document among the documents validated
+	// together. For the batch helpers, it is relative to the failing batch
+	// located by the enclosing *BatchError.
+	Record int
+
+	// Err is the reason the document is invalid: ErrDocumentNotObject,
+	// ErrMissingPrimaryKey, ErrInvalidPrimaryKey or ErrDuplicatePrimaryKey
+	Err error
+}
+
+// Error return a well human formatted message.
+func (e *DocumentError) Error() string {
+	return fmt.Sprintf("document %d: %v", e.Record, e.Err)
+}
+
+// Unwrap returns the reason the document is invalid
+func (e *DocumentError) Unwrap() error {
+	return e.Err
+}
+
+// DocumentsValidationError is returned when documents are found invalid
+// before being sent
+type DocumentsValidationError struct {
+	// Errors locate every invalid document, in order
+	Errors []*DocumentError
+}
+
+// Error return a well human formatted message.
+func (e *DocumentsValidationError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for j, err := range e.Errors {
+		if j == 10 {
+			messages = append(messages, fmt.Sprintf("and %d more", len(e.Errors)-j))
+			break
+		}
+		messages = append(messages, err.Error())
+	}
+	return fmt.Sprintf("%d invalid documents: %s", len(e.Errors), strings.Join(messages, "; "))
+}
+
+// PrimaryKeyInferenceError is returned by InferPrimaryKey when no attribute
+// of the first document contains "id"
+type PrimaryKeyInferenceError struct {
+	// Attributes are the attributes of the first document
+	Attributes []string
+}
+
+// Error return a well human formatted message.
+func (e *PrimaryKeyInferenceError) Error() string {
+	return fmt.Sprintf(`could not infer primary key: no attribute of the first document (%s) contains "id", pass the primary key explicitly`,
+		strings.Join(e.Attributes, ", "))
+}
+
+// SettingsValidationError lists the problems found by ValidateSettings
+type SettingsValidationError struct {
+	// Problems describe each invalid setting, prefixed by its location
+	// (e.g. `rankingRules[2]: unknown ranking rule "speed"`)
+	Problems []string
+}
+
+// Error return a well human formatted message.
+func (e *SettingsValidationError) Error() string {
+	return "invalid settings: " + strings.Join(e.Problems, "; ")
+}
+
+// SynonymsSyntaxError describes a malformed line of a Solr or WordNet
+// synonyms file
+type SynonymsSyntaxError struct {
+	// Line is the line of the input the error was found on, from 1
+	Line int
+
+	// Err is the reason the line is malformed
+	Err error
+}
+
+// Error return a well human formatted message.
+func (e *SynonymsSyntaxError) Error() string {
+	return fmt.Sprintf("synonyms line %d: %v", e.Line, e.Err)
+}
+
+// Unwrap returns the reason the line is malformed
+func (e *SynonymsSyntaxError) Unwrap() error {
+	return e.Err
+}
+
+// UnsupportedError is returned by methods relying on a route added by a later
+// version of Meilisearch than the one of the server. It matches
+// ErrUnsupportedByServer with errors.Is.
+type UnsupportedError struct {
+	// Function is the name of the method
+	Function string
+
+	// MinVersion is the first version of Meilisearch with the route
+	MinVersion string
+
+	// Err is the error returned by the server
+	Err error
+}
+
+// Error return a well human formatted message.
+func (e *UnsupportedError) Error() string {
+	return fmt.Sprintf("%s is unsupported by this server, it requires Meilisearch %s or later: %s", e.Function, e.MinVersion, e.Err)
+}
+
+// Unwrap returns the error returned by the server
+func (e *UnsupportedError) Unwrap() error {
+	return e.Err
+}
+
+// Is lets errors.Is match ErrUnsupportedByServer
+func (e *UnsupportedError) Is(target error) bool {
+	return target == ErrUnsupportedByServer
+}
+
+// isRouteNotFound reports whether err is the error returned by servers
+// without the route of a request: 404 for an unknown path, other than for a
+// missing index or document, or 405 for a path known with other methods.
+func isRouteNotFound(err error) bool {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.StatusCode {
+	case http.StatusNotFound:
+		return !errors.Is(err, ErrIndexNotFound) && !errors.Is(err, ErrDocumentNotFound)
+	case http.StatusMethodNotAllowed:
+		return true
+	default:
+		return false
+	}
+}
+
+// unsupportedError returns an *UnsupportedError wrapping err if it is the
+// error returned by servers without the route of function, err otherwise
+func unsupportedError(err error, function string, minVersion string) error {
+	if isRouteNotFound(err) {
+		return &UnsupportedError{Function: function, MinVersion: minVersion, Err: err}
+	}
+	return err
+}
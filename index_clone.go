@@ -0,0 +1,121 @@
+package meilisearch
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// CloneOptions configure CloneIndex
+type CloneOptions struct {
+	// Filter restricts the clone to the documents matching a filter
+	// expression, all documents are copied if empty. The attributes used in
+	// Filter must be filterable in the source index. It requires Meilisearch
+	// v1.2 or later, CloneIndex fails with an *UnsupportedError before
+	// creating the clone on older servers.
+	Filter string
+
+	// BatchSize is the number of documents fetched and added at a time,
+	// DefaultDocumentsPageSize if zero
+	BatchSize int
+
+	// Progress is called after each batch of documents is added to the
+	// clone
+	Progress func(CloneProgress)
+}
+
+// CloneProgress reports the progress of CloneIndex
+type CloneProgress struct {
+	// CopiedDocuments is the number of documents added to the clone so far
+	CopiedDocuments int64
+
+	// TotalDocuments is the number of documents of the source index, or 0 if
+	// unknown because CloneOptions.Filter is set
+	TotalDocuments int64
+}
+
+// CloneIndex copies the primary key, the settings and the documents of src to
+// a new index dstUID of dst, which may be the client of src or a client of
+// another instance. Only the documents matching options.Filter are copied if
+// set; options may be nil. CloneIndex fails if dstUID already exists, deletes
+// dstUID if a later step fails, and returns once every task succeeded.
+// Documents added or deleted in src during the clone may be skipped or copied
+// twice, stop writes to src for a consistent copy.
+func CloneIndex(ctx context.Context, src *Index, dst *Client, dstUID string, options *CloneOptions) (*Index, error) {
+	var opts CloneOptions
+	if options != nil {
+		opts = *options
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultDocumentsPageSize
+	}
+
+	info, err := src.FetchInfo()
+	if err != nil {
+		return nil, err
+	}
+	settings, err := src.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	progress := CloneProgress{}
+	if opts.Filter == "" {
+		stats, err := src.GetStats()
+		if err != nil {
+			return nil, err
+		}
+		progress.TotalDocuments = stats.NumberOfDocuments
+	} else {
+		// Check the server filters documents, and the filter itself
+		var documents []json.RawMessage
+		if err := src.GetDocuments(&DocumentsRequest{Limit: 1, Filter: opts.Filter}, &documents); err != nil {
+			return nil, errors.Wrapf(err, "could not clone index %q", src.UID)
+		}
+	}
+
+	index, err := dst.createIndexWithSettings(ctx, dstUID, info.PrimaryKey, settings)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not clone index %q", src.UID)
+	}
+
+	var batch []json.RawMessage
+	sendBatch := func() error {
+		task, err := index.AddDocuments(batch, info.PrimaryKey)
+		if err != nil {
+			return err
+		}
+		if _, err := dst.waitForTaskSucceeded(ctx, task); err != nil {
+			return errors.Wrapf(err, "could not add documents to index %q", dstUID)
+		}
+		progress.CopiedDocuments += int64(len(batch))
+		if opts.Progress != nil {
+			opts.Progress(progress)
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	it := src.Documents(ctx, &DocumentsIteratorOptions{
+		PageSize: int64(opts.BatchSize),
+		Filter:   opts.Filter,
+		Prefetch: true,
+	})
+	for it.Next() {
+		batch = append(batch, it.Raw())
+		if len(batch) == opts.BatchSize {
+			if err := sendBatch(); err != nil {
+				return nil, dst.discardIndex(dstUID, err)
+			}
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, dst.discardIndex(dstUID, err)
+	}
+	if len(batch) > 0 {
+		if err := sendBatch(); err != nil {
+			return nil, dst.discardIndex(dstUID, err)
+		}
+	}
+	return index, nil
+}
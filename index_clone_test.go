@@ -0,0 +1,103 @@
+package meilisearch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloneIndex(t *testing.T) {
+	c := defaultClient
+	t.Cleanup(cleanup(c))
+
+	src := c.Index("TestCloneIndex")
+	documents := testAddIteratedDocuments(t, src)
+	task, err := src.UpdateSettings(&Settings{
+		FilterableAttributes: []string{"tag"},
+		StopWords:            []string{"the"},
+	})
+	require.NoError(t, err)
+	testWaitForTask(t, src, task)
+	wantSettings, err := src.GetSettings()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		options      *CloneOptions
+		want         []docTestBooks
+		wantProgress []CloneProgress
+	}{
+		{
+			name:    "TestCloneIndexAllDocuments",
+			options: &CloneOptions{BatchSize: 2},
+			want:    documents,
+			wantProgress: []CloneProgress{
+				{CopiedDocuments: 2, TotalDocuments: 5},
+				{CopiedDocuments: 4, TotalDocuments: 5},
+				{CopiedDocuments: 5, TotalDocuments: 5},
+			},
+		},
+		{
+			name:    "TestCloneIndexFilter",
+			options: &CloneOptions{Filter: "tag = Tale"},
+			want:    []docTestBooks{documents[0], documents[2]},
+			wantProgress: []CloneProgress{
+				{CopiedDocuments: 2},
+			},
+		},
+		{
+			name: "TestCloneIndexNilOptions",
+			want: documents,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotProgress []CloneProgress
+			if tt.options != nil {
+				tt.options.Progress = func(progress CloneProgress) {
+					gotProgress = append(gotProgress, progress)
+				}
+			}
+
+			index, err := CloneIndex(context.Background(), src, c, tt.name, tt.options)
+			require.NoError(t, err)
+			require.Equal(t, tt.name, index.UID)
+			require.Equal(t, tt.wantProgress, gotProgress)
+
+			gotIndex, err := c.GetIndex(tt.name)
+			require.NoError(t, err)
+			require.Equal(t, "book_id", gotIndex.PrimaryKey)
+
+			gotSettings, err := index.GetSettings()
+			require.NoError(t, err)
+			require.Equal(t, wantSettings, gotSettings)
+
+			gotDocuments, err := GetDocumentsAs[docTestBooks](index, &DocumentsRequest{})
+			require.NoError(t, err)
+			require.Equal(t, tt.want, gotDocuments)
+		})
+	}
+
+	t.Run("TestCloneIndexCanceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		options := &CloneOptions{
+			BatchSize: 2,
+			// The next batch fails once the first one is added
+			Progress: func(CloneProgress) { cancel() },
+		}
+		_, err := CloneIndex(ctx, src, c, "TestCloneIndexCanceled", options)
+		require.ErrorIs(t, err, context.Canceled)
+
+		// The partial clone is deleted
+		_, err = c.GetIndex("TestCloneIndexCanceled")
+		require.ErrorIs(t, err, ErrIndexNotFound)
+	})
+
+	t.Run("TestCloneIndexExistingIndex", func(t *testing.T) {
+		_, err := CloneIndex(context.Background(), src, c, "TestCloneIndex", nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `index "TestCloneIndex" already exists`)
+	})
+}
@@ -3,7 +3,9 @@ package meilisearch
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/csv"
+	"encoding/json"
 	"io"
 	"io/ioutil"
 	"math"
@@ -16,21 +18,65 @@ import (
 )
 
 func (i Index) GetDocument(identifier string, documentPtr interface{}) error {
+	return i.getDocument(identifier, nil, documentPtr)
+}
+
+// getDocument gets the document identified by identifier with only fields, or
+// all fields if fields is empty
+func (i Index) getDocument(identifier string, fields []string, documentPtr interface{}) error {
+	if len(fields) == 0 {
+		return i.getDocumentFields(identifier, nil, documentPtr)
+	}
+	// Meilisearch v0.25 only selects the attributes of several documents, so
+	// those of a single document are also selected here
+	var document map[string]json.RawMessage
+	if err := i.getDocumentFields(identifier, fields, &document); err != nil {
+		return err
+	}
+	selected := make(map[string]json.RawMessage, len(fields))
+	for _, field := range fields {
+		if field == "*" {
+			selected = document
+			break
+		}
+		if value, ok := document[field]; ok {
+			selected[field] = value
+		}
+	}
+	data, err := json.Marshal(selected)
+	if err != nil {
+		return errors.Wrap(err, "could not encode document")
+	}
+	return errors.Wrap(json.Unmarshal(data, documentPtr), "could not decode document")
+}
+
+func (i Index) getDocumentFields(identifier string, fields []string, documentPtr interface{}) error {
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/documents/" + identifier,
 		method:              http.MethodGet,
 		withRequest:         nil,
 		withResponse:        documentPtr,
+		withQueryParams:     map[string]string{},
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "GetDocument",
 	}
+	if len(fields) != 0 {
+		req.withQueryParams["attributesToRetrieve"] = strings.Join(fields, ",")
+	}
 	if err := i.client.executeRequest(req); err != nil {
 		return err
 	}
 	return nil
 }
 
+// GetDocuments gets the documents selected by request into resp, a pointer to
+// a slice. Documents are only filtered by request.Filter with Meilisearch v1.2
+// or later, an *UnsupportedError is returned by older servers rather than all
+// documents.
 func (i Index) GetDocuments(request *DocumentsRequest, resp interface{}) error {
+	if request.Filter != "" {
+		return i.fetchDocuments(request, resp)
+	}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/documents",
 		method:              http.MethodGet,
@@ -55,7 +101,62 @@ func (i Index) GetDocuments(request *DocumentsRequest, resp interface{}) error {
 	return nil
 }
 
+// fetchDocumentsRequest is the request body of the documents fetch route
+type fetchDocumentsRequest struct {
+	Offset int64    `json:"offset,omitempty"`
+	Limit  int64    `json:"limit,omitempty"`
+	Fields []string `json:"fields,omitempty"`
+	Filter string   `json:"filter"`
+}
+
+// fetchDocumentsResponse is the response body of the documents fetch route
+type fetchDocumentsResponse struct {
+	Results json.RawMessage `json:"results"`
+	Offset  int64           `json:"offset"`
+	Limit   int64           `json:"limit"`
+	Total   int64           `json:"total"`
+}
+
+// fetchDocuments gets the documents selected by request, filter included,
+// through the documents fetch route of Meilisearch v1.2
+func (i Index) fetchDocuments(request *DocumentsRequest, resp interface{}) error {
+	body := &fetchDocumentsRequest{
+		Offset: request.Offset,
+		Limit:  request.Limit,
+		Fields: request.AttributesToRetrieve,
+		Filter: request.Filter,
+	}
+	page := &fetchDocumentsResponse{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/documents/fetch",
+		method:              http.MethodPost,
+		contentType:         contentTypeJSON,
+		withRequest:         body,
+		withResponse:        page,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetDocuments",
+	}
+	if err := i.client.executeRequest(req); err != nil {
+		return unsupportedError(err, "GetDocuments with a filter", "v1.2")
+	}
+	return errors.Wrap(json.Unmarshal(page.Results, resp), "could not decode documents")
+}
+
 func (i Index) addDocuments(documentsPtr interface{}, contentType string, primaryKey ...string) (resp *Task, err error) {
+	if contentType == contentTypeJSON {
+		if primaryKey, err = i.inferPrimaryKey(documentsPtr, primaryKey); err != nil {
+			return nil, err
+		}
+	}
+	if err := i.validateDocuments(documentsPtr, contentType, primaryKey...); err != nil {
+		return nil, err
+	}
+	return i.sendDocuments(documentsPtr, http.MethodPost, contentType, "AddDocuments", primaryKey...)
+}
+
+// sendDocuments adds (POST) or updates (PUT) documents without validating
+// them.
+func (i Index) sendDocuments(documentsPtr interface{}, method string, contentType string, functionName string, primaryKey ...string) (resp *Task, err error) {
 	resp = &Task{}
 	endpoint := ""
 	if primaryKey == nil {
@@ -66,12 +167,12 @@ func (i Index) addDocuments(documentsPtr interface{}, contentType string, primar
 	}
 	req := internalRequest{
 		endpoint:            endpoint,
-		method:              http.MethodPost,
+		method:              method,
 		contentType:         contentType,
 		withRequest:         documentsPtr,
 		withResponse:        resp,
 		acceptedStatusCodes: []int{http.StatusAccepted},
-		functionName:        "AddDocuments",
+		functionName:        functionName,
 	}
 	if err = i.client.executeRequest(req); err != nil {
 		return nil, err
@@ -79,16 +180,29 @@ func (i Index) addDocuments(documentsPtr interface{}, contentType string, primar
 	return resp, nil
 }
 
+// AddDocuments adds documentsPtr, a slice of documents. If no primary key is
+// given and the index has none, the server infers it. InferPrimaryKey returns
+// the one it would infer, and is used to send it explicitly if the client is
+// configured with ClientConfig.InferPrimaryKey.
 func (i Index) AddDocuments(documentsPtr interface{}, primaryKey ...string) (resp *Task, err error) {
 	return i.addDocuments(documentsPtr, contentTypeJSON, primaryKey...)
 }
 
+// AddDocumentsInBatches adds documentsPtr, a slice of documents, batchSize
+// documents at a time. Like AddDocuments, the primary key inferred from the
+// first document is sent with every batch if the client is configured with
+// ClientConfig.InferPrimaryKey.
 func (i Index) AddDocumentsInBatches(documentsPtr interface{}, batchSize int, primaryKey ...string) (resp []Task, err error) {
+	if primaryKey, err = i.inferPrimaryKey(documentsPtr, primaryKey); err != nil {
+		return nil, err
+	}
+
 	arr := reflect.ValueOf(documentsPtr)
 	lenDocs := arr.Len()
 	numBatches := int(math.Ceil(float64(lenDocs) / float64(batchSize)))
 	resp = make([]Task, numBatches)
 
+	// Validate every batch before sending the first one
 	for j := 0; j < numBatches; j++ {
 		end := (j + 1) * batchSize
 		if end > lenDocs {
@@ -96,21 +210,84 @@ func (i Index) AddDocumentsInBatches(documentsPtr interface{}, batchSize int, pr
 		}
 
 		batch := arr.Slice(j*batchSize, end).Interface()
+		if err := i.validateDocuments(batch, contentTypeJSON, primaryKey...); err != nil {
+			return nil, newSliceBatchError(nil, j, batchSize, end, err)
+		}
+	}
 
-		if primaryKey != nil {
-			respID, err := i.AddDocuments(batch, primaryKey[0])
-			if err != nil {
-				return nil, err
+	for j := 0; j < numBatches; j++ {
+		end := (j + 1) * batchSize
+		if end > lenDocs {
+			end = lenDocs
+		}
+
+		batch := arr.Slice(j*batchSize, end).Interface()
+		respID, err := i.sendDocuments(batch, http.MethodPost, contentTypeJSON, "AddDocuments", primaryKey...)
+		if err != nil {
+			return nil, newSliceBatchError(resp[:j], j, batchSize, end, err)
+		}
+
+		resp[j] = *respID
+	}
+
+	return resp, nil
+}
+
+// sendInBatches passes the elements returned by next to send, batchSize at a
+// time, until next reports there is no element left or ctx is done. The tasks
+// of the batches enqueued are always returned, along with a *BatchError
+// holding them if a batch failed or ctx is done, in which case the elements
+// of the batch being assembled are not sent.
+func sendInBatches[T any](ctx context.Context, batchSize int, next func() (element T, ok bool, err error), send func(batch []T) (*Task, error)) (resp []Task, err error) {
+	if batchSize <= 0 {
+		return nil, errors.Errorf("invalid batch size %d, must be positive", batchSize)
+	}
+	var (
+		batch []T
+		// Number of elements returned by next so far
+		count int
+	)
+
+	sendBatch := func() error {
+		task, err := send(batch)
+		if err != nil {
+			return newSliceBatchError(resp, len(resp), batchSize, count, err)
+		}
+		resp = append(resp, *task)
+		batch = nil
+		return nil
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return resp, newSliceBatchError(resp, len(resp), batchSize, count, err)
+		}
+		element, ok, err := next()
+		if err != nil {
+			end := count
+			if ctx.Err() == nil {
+				// The failing element belongs to the batch being assembled
+				end++
 			}
+			return resp, newSliceBatchError(resp, len(resp), batchSize, end, err)
+		}
+		if !ok {
+			break
+		}
+		batch = append(batch, element)
+		count++
 
-			resp[j] = *respID
-		} else {
-			respID, err := i.AddDocuments(batch)
-			if err != nil {
-				return nil, err
+		if len(batch) == batchSize {
+			if err := sendBatch(); err != nil {
+				return resp, err
 			}
+		}
+	}
 
-			resp[j] = *respID
+	// Send remaining elements as the last batch if there is any
+	if len(batch) > 0 {
+		if err := sendBatch(); err != nil {
+			return resp, err
 		}
 	}
 
@@ -144,187 +321,347 @@ func (i Index) AddDocumentsCsvFromReaderInBatches(documents io.Reader, batchSize
 	// expected.
 	// Records are read and sent continuously to avoid reading all content
 	// into memory. However, this means that only part of the documents might
-	// be added successfully.
+	// be added successfully, in which case a *BatchError holding the tasks
+	// already enqueued is returned.
+
+	var responses []Task
+
+	batchErr := readCsvBatches(documents, batchSize, 0, nil, func(batch *documentsBatch) error {
+		resp, err := i.AddDocumentsCsv(batch.payload, primaryKey...)
+		if err != nil {
+			return err
+		}
+		responses = append(responses, *resp)
+		return nil
+	})
+	if batchErr != nil {
+		batchErr.Tasks = responses
+		batchErr.BatchIndex = len(responses)
+		return nil, batchErr
+	}
+
+	return responses, nil
+}
+
+func (i Index) AddDocumentsNdjson(documents []byte, primaryKey ...string) (resp *Task, err error) {
+	// []byte avoids JSON conversion in Client.sendRequest()
+	return i.addDocuments([]byte(documents), contentTypeNDJSON, primaryKey...)
+}
+
+func (i Index) AddDocumentsNdjsonFromReader(documents io.Reader, primaryKey ...string) (resp *Task, err error) {
+	// Using io.Reader would avoid JSON conversion in Client.sendRequest(), but
+	// read content to memory anyway because of problems with streamed bodies
+	data, err := ioutil.ReadAll(documents)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read documents")
+	}
+	return i.addDocuments(data, contentTypeNDJSON, primaryKey...)
+}
+
+func (i Index) AddDocumentsNdjsonInBatches(documents []byte, batchSize int, primaryKey ...string) (resp []Task, err error) {
+	// Reuse io.Reader implementation
+	return i.AddDocumentsNdjsonFromReaderInBatches(bytes.NewReader(documents), batchSize, primaryKey...)
+}
+
+func (i Index) AddDocumentsNdjsonFromReaderInBatches(documents io.Reader, batchSize int, primaryKey ...string) (resp []Task, err error) {
+	// NDJSON files supposed to contain a valid JSON document in each line, so
+	// it's safe to split by lines.
+	// Lines are read and sent continuously to avoid reading all content into
+	// memory. However, this means that only part of the documents might be
+	// added successfully, in which case a *BatchError holding the tasks
+	// already enqueued is returned.
+
+	var responses []Task
+
+	batchErr := readNdjsonBatches(documents, batchSize, 0, func(batch *documentsBatch) error {
+		resp, err := i.AddDocumentsNdjson(batch.payload, primaryKey...)
+		if err != nil {
+			return err
+		}
+		responses = append(responses, *resp)
+		return nil
+	})
+	if batchErr != nil {
+		batchErr.Tasks = responses
+		batchErr.BatchIndex = len(responses)
+		return nil, batchErr
+	}
+
+	return responses, nil
+}
+
+// documentsBatch is a part of a CSV or NDJSON input ready to be sent, along
+// with its position in the input.
+type documentsBatch struct {
+	payload []byte
+
+	// Records of the input in the batch (end excluded)
+	start int
+	end   int
+
+	// Bytes of the input in the batch (endOffset excluded), -1 if unknown
+	startOffset int64
+	endOffset   int64
+}
+
+func (b *documentsBatch) batchError(err error) *BatchError {
+	return &BatchError{
+		Start:       b.start,
+		End:         b.end,
+		StartOffset: b.startOffset,
+		EndOffset:   b.endOffset,
+		OriginError: err,
+	}
+}
+
+// readCsvBatches splits a CSV input into batches of batchSize records, each
+// starting with the header record, and calls send for each of them. The first
+// skip records after the header are read but not sent. The header and records
+// are transformed according to options, which may be nil.
+// The returned *BatchError only locates the failing batch, the caller is in
+// charge of filling in the tasks.
+func readCsvBatches(documents io.Reader, batchSize int, skip int, options *CsvOptions, send func(batch *documentsBatch) error) *BatchError {
+	if options == nil {
+		options = &CsvOptions{}
+	}
 
 	var (
-		responses []Task
-		header    []string
-		records   [][]string
+		header  *csvHeader
+		records [][]string
+		// Number of data records read so far
+		count int
+		// Index of the first data record of the batch being assembled
+		start int
 	)
 
-	sendCsvRecords := func(records [][]string) (*Task, error) {
+	sendCsvRecords := func(records [][]string) *BatchError {
+		batch := &documentsBatch{
+			start:       start,
+			end:         count,
+			startOffset: -1,
+			endOffset:   -1,
+		}
+
 		b := new(bytes.Buffer)
 		w := csv.NewWriter(b)
 		w.UseCRLF = true // Keep output RFC 4180 compliant
 		err := w.WriteAll(records)
 		if err != nil {
-			return nil, errors.Wrap(err, "could not write CSV records")
+			return batch.batchError(errors.Wrap(err, "could not write CSV records"))
 		}
+		batch.payload = b.Bytes()
 
-		resp, err := i.AddDocumentsCsv(b.Bytes(), primaryKey...)
-		if err != nil {
-			return nil, err
+		if err := send(batch); err != nil {
+			return batch.batchError(err)
 		}
-		return resp, nil
+		return nil
+	}
+
+	// failRecord locates the error on the current record, which belongs to the
+	// batch being assembled
+	failRecord := func(err error) *BatchError {
+		batch := &documentsBatch{
+			start:       start,
+			end:         count,
+			startOffset: -1,
+			endOffset:   -1,
+		}
+		if len(records) == 0 {
+			batch.start = count - 1
+		}
+		return batch.batchError(err)
 	}
 
 	r := csv.NewReader(documents)
+	if options.Delimiter != 0 {
+		r.Comma = options.Delimiter
+	}
+	r.LazyQuotes = options.LazyQuotes
 	for {
-		// Read CSV record (empty lines and comments are already skipped by csv.Reader)
+		// Read CSV record (empty lines are already skipped by csv.Reader)
 		record, err := r.Read()
 		if err == io.EOF {
 			break
 		}
-		if err != nil {
-			return nil, errors.Wrap(err, "could not read CSV record")
-		}
 
 		// Store first record as header
 		if header == nil {
-			header = record
+			if err != nil {
+				return &BatchError{StartOffset: -1, EndOffset: -1, OriginError: errors.Wrap(err, "could not read CSV header")}
+			}
+			if header, err = newCsvHeader(record, options); err != nil {
+				return &BatchError{StartOffset: -1, EndOffset: -1, OriginError: err}
+			}
+			continue
+		}
+
+		count++
+		var rowErr *CsvRowError
+		if err == nil {
+			record, rowErr = header.convert(r, record)
+		} else {
+			rowErr = &CsvRowError{Err: err}
+			if parseErr, ok := err.(*csv.ParseError); ok {
+				rowErr.Line = parseErr.StartLine
+			}
+		}
+		if rowErr != nil {
+			rowErr.Record = count - 1
+			if options.OnMalformedRow == nil {
+				return failRecord(errors.Wrap(rowErr, "could not read CSV record"))
+			}
+			if count > skip {
+				options.OnMalformedRow(rowErr)
+			}
+			continue
+		}
+
+		if count <= skip {
 			continue
 		}
 
 		// Add header record to every batch
 		if len(records) == 0 {
-			records = append(records, header)
+			records = append(records, header.record)
+			start = count - 1
 		}
 
 		records = append(records, record)
 
 		// After reaching batchSize (not counting the header record) assemble a CSV file and send records
 		if len(records) == batchSize+1 {
-			resp, err := sendCsvRecords(records)
-			if err != nil {
-				return nil, err
+			if batchErr := sendCsvRecords(records); batchErr != nil {
+				return batchErr
 			}
-			responses = append(responses, *resp)
 			records = nil
 		}
 	}
 
 	// Send remaining records as the last batch if there is any
 	if len(records) > 0 {
-		resp, err := sendCsvRecords(records)
-		if err != nil {
-			return nil, err
+		if batchErr := sendCsvRecords(records); batchErr != nil {
+			return batchErr
 		}
-		responses = append(responses, *resp)
 	}
 
-	return responses, nil
-}
-
-func (i Index) AddDocumentsNdjson(documents []byte, primaryKey ...string) (resp *Task, err error) {
-	// []byte avoids JSON conversion in Client.sendRequest()
-	return i.addDocuments([]byte(documents), contentTypeNDJSON, primaryKey...)
+	return nil
 }
 
-func (i Index) AddDocumentsNdjsonFromReader(documents io.Reader, primaryKey ...string) (resp *Task, err error) {
-	// Using io.Reader would avoid JSON conversion in Client.sendRequest(), but
-	// read content to memory anyway because of problems with streamed bodies
-	data, err := ioutil.ReadAll(documents)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not read documents")
-	}
-	return i.addDocuments(data, contentTypeNDJSON, primaryKey...)
-}
+// maxNdjsonLineSize is the maximum size of a NDJSON line, the default payload
+// size limit of Meilisearch since a larger document could not be sent anyway
+const maxNdjsonLineSize = 100 * 1024 * 1024
 
-func (i Index) AddDocumentsNdjsonInBatches(documents []byte, batchSize int, primaryKey ...string) (resp []Task, err error) {
-	// Reuse io.Reader implementation
-	return i.AddDocumentsNdjsonFromReaderInBatches(bytes.NewReader(documents), batchSize, primaryKey...)
-}
+// readNdjsonBatches splits an NDJSON input into batches of batchSize lines
+// and calls send for each of them. The first skip non-empty lines are read but
+// not sent.
+// The returned *BatchError only locates the failing batch, the caller is in
+// charge of filling in the tasks.
+func readNdjsonBatches(documents io.Reader, batchSize int, skip int, send func(batch *documentsBatch) error) *BatchError {
+	var (
+		lines []string
+		// Number of non-empty lines read so far
+		count int
+		// Bytes consumed by the scanner so far, offset following the last
+		// non-empty line and offset of the first line of the current batch
+		offset      int64
+		lineOffset  int64
+		batchOffset int64
+	)
 
-func (i Index) AddDocumentsNdjsonFromReaderInBatches(documents io.Reader, batchSize int, primaryKey ...string) (resp []Task, err error) {
-	// NDJSON files supposed to contain a valid JSON document in each line, so
-	// it's safe to split by lines.
-	// Lines are read and sent continuously to avoid reading all content into
-	// memory. However, this means that only part of the documents might be
-	// added successfully.
+	sendNdjsonLines := func(lines []string) *BatchError {
+		batch := &documentsBatch{
+			start:       count - len(lines),
+			end:         count,
+			startOffset: batchOffset,
+			endOffset:   lineOffset,
+		}
 
-	sendNdjsonLines := func(lines []string) (*Task, error) {
 		b := new(bytes.Buffer)
 		for _, line := range lines {
 			_, err := b.WriteString(line)
 			if err != nil {
-				return nil, errors.Wrap(err, "could not write NDJSON line")
+				return batch.batchError(errors.Wrap(err, "could not write NDJSON line"))
 			}
 			err = b.WriteByte('\n')
 			if err != nil {
-				return nil, errors.Wrap(err, "could not write NDJSON line")
+				return batch.batchError(errors.Wrap(err, "could not write NDJSON line"))
 			}
 		}
+		batch.payload = b.Bytes()
 
-		resp, err := i.AddDocumentsNdjson(b.Bytes(), primaryKey...)
-		if err != nil {
-			return nil, err
+		if err := send(batch); err != nil {
+			return batch.batchError(err)
 		}
-		return resp, nil
+		return nil
 	}
 
-	var (
-		responses []Task
-		lines     []string
-	)
-
 	scanner := bufio.NewScanner(documents)
+	scanner.Buffer(nil, maxNdjsonLineSize)
+	scanner.Split(func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		advance, token, err = bufio.ScanLines(data, atEOF)
+		offset += int64(advance)
+		return advance, token, err
+	})
 	for scanner.Scan() {
 		line := strings.TrimSpace(scanner.Text())
 
 		// Skip empty lines (NDJSON might not allow this, but just to be sure)
 		if line == "" {
+			if len(lines) == 0 {
+				lineOffset = offset
+			}
+			continue
+		}
+
+		count++
+		if count <= skip {
+			lineOffset = offset
 			continue
 		}
 
+		if len(lines) == 0 {
+			batchOffset = lineOffset
+		}
+		lineOffset = offset
+
 		lines = append(lines, line)
 		// After reaching batchSize send NDJSON lines
 		if len(lines) == batchSize {
-			resp, err := sendNdjsonLines(lines)
-			if err != nil {
-				return nil, err
+			if batchErr := sendNdjsonLines(lines); batchErr != nil {
+				return batchErr
 			}
-			responses = append(responses, *resp)
 			lines = nil
 		}
 	}
 	if err := scanner.Err(); err != nil {
-		return nil, errors.Wrap(err, "could not read NDJSON")
+		// The unreadable line belongs to the batch being assembled
+		batch := &documentsBatch{
+			start:       count - len(lines),
+			end:         count + 1,
+			startOffset: batchOffset,
+			endOffset:   offset,
+		}
+		if len(lines) == 0 {
+			batch.startOffset = lineOffset
+		}
+		return batch.batchError(errors.Wrap(err, "could not read NDJSON"))
 	}
 
 	// Send remaining records as the last batch if there is any
 	if len(lines) > 0 {
-		resp, err := sendNdjsonLines(lines)
-		if err != nil {
-			return nil, err
+		if batchErr := sendNdjsonLines(lines); batchErr != nil {
+			return batchErr
 		}
-		responses = append(responses, *resp)
 	}
 
-	return responses, nil
+	return nil
 }
 
 func (i Index) UpdateDocuments(documentsPtr interface{}, primaryKey ...string) (resp *Task, err error) {
-	resp = &Task{}
-	endpoint := ""
-	if primaryKey == nil {
-		endpoint = "/indexes/" + i.UID + "/documents"
-	} else {
-		i.PrimaryKey = primaryKey[0] //nolint:golint,staticcheck
-		endpoint = "/indexes/" + i.UID + "/documents?primaryKey=" + primaryKey[0]
-	}
-	req := internalRequest{
-		endpoint:            endpoint,
-		method:              http.MethodPut,
-		contentType:         contentTypeJSON,
-		withRequest:         documentsPtr,
-		withResponse:        resp,
-		acceptedStatusCodes: []int{http.StatusAccepted},
-		functionName:        "UpdateDocuments",
-	}
-	if err = i.client.executeRequest(req); err != nil {
+	if err := i.validateDocuments(documentsPtr, contentTypeJSON, primaryKey...); err != nil {
 		return nil, err
 	}
-	return resp, nil
+	return i.sendDocuments(documentsPtr, http.MethodPut, contentTypeJSON, "UpdateDocuments", primaryKey...)
 }
 
 func (i Index) UpdateDocumentsInBatches(documentsPtr interface{}, batchSize int, primaryKey ...string) (resp []Task, err error) {
@@ -333,6 +670,7 @@ func (i Index) UpdateDocumentsInBatches(documentsPtr interface{}, batchSize int,
 	numBatches := int(math.Ceil(float64(lenDocs) / float64(batchSize)))
 	resp = make([]Task, numBatches)
 
+	// Validate every batch before sending the first one
 	for j := 0; j < numBatches; j++ {
 		end := (j + 1) * batchSize
 		if end > lenDocs {
@@ -340,26 +678,43 @@ func (i Index) UpdateDocumentsInBatches(documentsPtr interface{}, batchSize int,
 		}
 
 		batch := arr.Slice(j*batchSize, end).Interface()
-		if primaryKey != nil {
-			respID, err := i.UpdateDocuments(batch, primaryKey[0])
-			if err != nil {
-				return nil, err
-			}
+		if err := i.validateDocuments(batch, contentTypeJSON, primaryKey...); err != nil {
+			return nil, newSliceBatchError(nil, j, batchSize, end, err)
+		}
+	}
 
-			resp[j] = *respID
-		} else {
-			respID, err := i.UpdateDocuments(batch)
-			if err != nil {
-				return nil, err
-			}
+	for j := 0; j < numBatches; j++ {
+		end := (j + 1) * batchSize
+		if end > lenDocs {
+			end = lenDocs
+		}
 
-			resp[j] = *respID
+		batch := arr.Slice(j*batchSize, end).Interface()
+		respID, err := i.sendDocuments(batch, http.MethodPut, contentTypeJSON, "UpdateDocuments", primaryKey...)
+		if err != nil {
+			return nil, newSliceBatchError(resp[:j], j, batchSize, end, err)
 		}
+
+		resp[j] = *respID
 	}
 
 	return resp, nil
 }
 
+// newSliceBatchError builds the error returned when the batch j of a slice
+// split into batches of batchSize documents fails.
+func newSliceBatchError(tasks []Task, j int, batchSize int, end int, err error) *BatchError {
+	return &BatchError{
+		Tasks:       tasks,
+		BatchIndex:  j,
+		Start:       j * batchSize,
+		End:         end,
+		StartOffset: -1,
+		EndOffset:   -1,
+		OriginError: err,
+	}
+}
+
 func (i Index) DeleteDocument(identifier string) (resp *Task, err error) {
 	resp = &Task{}
 	req := internalRequest{
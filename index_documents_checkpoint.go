@@ -0,0 +1,218 @@
+package meilisearch
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// Checkpoint records the progress of a resumable ingestion. It is saved after
+// every batch confirmed to be successfully processed by Meilisearch.
+type Checkpoint struct {
+	// Records is the number of records of the input confirmed to be added
+	// (data records for CSV, non-empty lines for NDJSON)
+	Records int `json:"records"`
+
+	// Offset is the position in bytes in the input following the last
+	// confirmed record. It is only known for NDJSON input and is -1 otherwise.
+	Offset int64 `json:"offset"`
+
+	// TaskUIDs are the uids of the tasks of the confirmed batches
+	TaskUIDs []int64 `json:"taskUids"`
+}
+
+// CheckpointStore persists the Checkpoint of a resumable ingestion.
+// A store must only be used for a single input.
+type CheckpointStore interface {
+	// Load returns the last saved checkpoint, or nil if there is none
+	Load() (*Checkpoint, error)
+	// Save replaces the saved checkpoint
+	Save(checkpoint *Checkpoint) error
+	// Clear removes the saved checkpoint once the ingestion is complete
+	Clear() error
+}
+
+// FileCheckpointStore is a CheckpointStore saving the checkpoint as JSON in a
+// local file.
+type FileCheckpointStore struct {
+	Path string
+}
+
+var _ CheckpointStore = &FileCheckpointStore{}
+
+// NewFileCheckpointStore creates a FileCheckpointStore saving the checkpoint
+// in the file at path
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{
+		Path: path,
+	}
+}
+
+func (s *FileCheckpointStore) Load() (*Checkpoint, error) {
+	data, err := ioutil.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read checkpoint")
+	}
+	checkpoint := &Checkpoint{}
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return nil, errors.Wrap(err, "could not decode checkpoint")
+	}
+	return checkpoint, nil
+}
+
+func (s *FileCheckpointStore) Save(checkpoint *Checkpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return errors.Wrap(err, "could not encode checkpoint")
+	}
+
+	if err := writeFileAtomic(s.Path, data); err != nil {
+		return errors.Wrap(err, "could not write checkpoint")
+	}
+	return nil
+}
+
+func (s *FileCheckpointStore) Clear() error {
+	if err := os.Remove(s.Path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "could not remove checkpoint")
+	}
+	return nil
+}
+
+// writeFileAtomic writes data to a temporary file renamed over the file at
+// path, so that a crash never leaves a truncated file behind.
+func writeFileAtomic(path string, data []byte) error {
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// AddDocumentsNdjsonFromReaderInBatchesWithCheckpoint works like
+// AddDocumentsNdjsonFromReaderInBatches but waits for each batch to be
+// processed and saves a checkpoint in store once it succeeded.
+// If store holds a checkpoint, the records it covers are skipped: documents is
+// seeked to the checkpoint offset if it implements io.Seeker, otherwise the
+// records are read and dropped. The checkpoint is cleared once all documents
+// are added.
+func (i Index) AddDocumentsNdjsonFromReaderInBatchesWithCheckpoint(ctx context.Context, documents io.Reader, batchSize int, store CheckpointStore, primaryKey ...string) (resp []Task, err error) {
+	checkpoint, err := store.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		skip int
+		// Position of documents in the input after seeking
+		baseRecords int
+		baseOffset  int64
+	)
+	if checkpoint == nil {
+		checkpoint = &Checkpoint{}
+	} else if seeker, ok := documents.(io.Seeker); ok && checkpoint.Offset >= 0 {
+		if _, err := seeker.Seek(checkpoint.Offset, io.SeekStart); err != nil {
+			return nil, errors.Wrap(err, "could not seek to checkpoint")
+		}
+		baseRecords = checkpoint.Records
+		baseOffset = checkpoint.Offset
+	} else {
+		skip = checkpoint.Records
+	}
+
+	var responses []Task
+
+	batchErr := readNdjsonBatches(documents, batchSize, skip, func(batch *documentsBatch) error {
+		task, err := i.AddDocumentsNdjson(batch.payload, primaryKey...)
+		if err != nil {
+			return err
+		}
+		if _, err := i.client.waitForTaskSucceeded(ctx, task); err != nil {
+			return err
+		}
+		responses = append(responses, *task)
+
+		checkpoint.Records = baseRecords + batch.end
+		checkpoint.Offset = baseOffset + batch.endOffset
+		checkpoint.TaskUIDs = append(checkpoint.TaskUIDs, task.UID)
+		return store.Save(checkpoint)
+	})
+	if batchErr != nil {
+		batchErr.Tasks = responses
+		batchErr.BatchIndex = len(responses)
+		batchErr.Start += baseRecords
+		batchErr.End += baseRecords
+		batchErr.StartOffset += baseOffset
+		batchErr.EndOffset += baseOffset
+		return nil, batchErr
+	}
+
+	if err := store.Clear(); err != nil {
+		return nil, err
+	}
+	return responses, nil
+}
+
+// AddDocumentsCsvFromReaderInBatchesWithCheckpoint works like
+// AddDocumentsCsvFromReaderInBatches but waits for each batch to be processed
+// and saves a checkpoint in store once it succeeded.
+// If store holds a checkpoint, the records it covers are read and dropped. The
+// checkpoint is cleared once all documents are added.
+func (i Index) AddDocumentsCsvFromReaderInBatchesWithCheckpoint(ctx context.Context, documents io.Reader, batchSize int, store CheckpointStore, primaryKey ...string) (resp []Task, err error) {
+	checkpoint, err := store.Load()
+	if err != nil {
+		return nil, err
+	}
+	if checkpoint == nil {
+		checkpoint = &Checkpoint{}
+	}
+	// The header row has to be read again, so CSV input is never seeked
+	checkpoint.Offset = -1
+
+	var responses []Task
+
+	batchErr := readCsvBatches(documents, batchSize, checkpoint.Records, nil, func(batch *documentsBatch) error {
+		task, err := i.AddDocumentsCsv(batch.payload, primaryKey...)
+		if err != nil {
+			return err
+		}
+		if _, err := i.client.waitForTaskSucceeded(ctx, task); err != nil {
+			return err
+		}
+		responses = append(responses, *task)
+
+		checkpoint.Records = batch.end
+		checkpoint.TaskUIDs = append(checkpoint.TaskUIDs, task.UID)
+		return store.Save(checkpoint)
+	})
+	if batchErr != nil {
+		batchErr.Tasks = responses
+		batchErr.BatchIndex = len(responses)
+		return nil, batchErr
+	}
+
+	if err := store.Clear(); err != nil {
+		return nil, err
+	}
+	return responses, nil
+}
@@ -0,0 +1,163 @@
+package meilisearch
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"path/filepath"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileCheckpointStore(t *testing.T) {
+	store := NewFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoint.json"))
+
+	checkpoint, err := store.Load()
+	require.NoError(t, err)
+	require.Nil(t, checkpoint)
+
+	want := &Checkpoint{Records: 4, Offset: 82, TaskUIDs: []int64{12, 13}}
+	require.NoError(t, store.Save(want))
+	checkpoint, err = store.Load()
+	require.NoError(t, err)
+	require.Equal(t, want, checkpoint)
+
+	want.Records = 6
+	want.TaskUIDs = append(want.TaskUIDs, 14)
+	require.NoError(t, store.Save(want))
+	checkpoint, err = store.Load()
+	require.NoError(t, err)
+	require.Equal(t, want, checkpoint)
+
+	require.NoError(t, store.Clear())
+	checkpoint, err = store.Load()
+	require.NoError(t, err)
+	require.Nil(t, checkpoint)
+	require.NoError(t, store.Clear())
+}
+
+func TestIndex_AddDocumentsNdjsonFromReaderInBatchesWithCheckpoint(t *testing.T) {
+	// Offset of the third document of testNdjsonDocuments
+	thirdOffset := int64(bytes.Index(testNdjsonDocuments, []byte(`{"id": 3`)))
+
+	tests := []struct {
+		name       string
+		UID        string
+		checkpoint *Checkpoint
+		documents  func() io.Reader
+		wantTasks  int
+		wantIDs    []float64
+	}{
+		{
+			name:      "TestIndexNdjsonWithCheckpointFromStart",
+			UID:       "TestIndexNdjsonWithCheckpointFromStart",
+			documents: func() io.Reader { return bytes.NewReader(testNdjsonDocuments) },
+			wantTasks: 3,
+			wantIDs:   []float64{1, 2, 3, 4, 5},
+		},
+		{
+			name:       "TestIndexNdjsonWithCheckpointResumeSeeker",
+			UID:        "TestIndexNdjsonWithCheckpointResumeSeeker",
+			checkpoint: &Checkpoint{Records: 2, Offset: thirdOffset, TaskUIDs: []int64{0}},
+			documents:  func() io.Reader { return bytes.NewReader(testNdjsonDocuments) },
+			wantTasks:  2,
+			wantIDs:    []float64{3, 4, 5},
+		},
+		{
+			name:       "TestIndexNdjsonWithCheckpointResumeReader",
+			UID:        "TestIndexNdjsonWithCheckpointResumeReader",
+			checkpoint: &Checkpoint{Records: 2, Offset: thirdOffset, TaskUIDs: []int64{0}},
+			documents:  func() io.Reader { return io.MultiReader(bytes.NewReader(testNdjsonDocuments)) },
+			wantTasks:  2,
+			wantIDs:    []float64{3, 4, 5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := defaultClient
+			i := c.Index(tt.UID)
+			t.Cleanup(cleanup(c))
+
+			store := NewFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoint.json"))
+			if tt.checkpoint != nil {
+				require.NoError(t, store.Save(tt.checkpoint))
+			}
+
+			gotResp, err := i.AddDocumentsNdjsonFromReaderInBatchesWithCheckpoint(context.Background(), tt.documents(), 2, store)
+			require.NoError(t, err)
+			require.Len(t, gotResp, tt.wantTasks)
+
+			checkpoint, err := store.Load()
+			require.NoError(t, err)
+			require.Nil(t, checkpoint)
+
+			var documents []map[string]interface{}
+			err = i.GetDocuments(&DocumentsRequest{}, &documents)
+			require.NoError(t, err)
+			var gotIDs []float64
+			for _, document := range documents {
+				gotIDs = append(gotIDs, document["id"].(float64))
+			}
+			require.Equal(t, tt.wantIDs, gotIDs)
+		})
+	}
+}
+
+func TestIndex_AddDocumentsNdjsonFromReaderInBatchesWithCheckpointFailure(t *testing.T) {
+	c := defaultClient
+	i := c.Index("TestIndexNdjsonWithCheckpointFailure")
+	t.Cleanup(cleanup(c))
+
+	store := NewFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoint.json"))
+	documents := io.MultiReader(
+		bytes.NewReader(testNdjsonDocuments),
+		iotest.ErrReader(errors.New("connection reset")),
+	)
+
+	gotResp, err := i.AddDocumentsNdjsonFromReaderInBatchesWithCheckpoint(context.Background(), documents, 2, store)
+	require.Error(t, err)
+	require.Nil(t, gotResp)
+	var batchErr *BatchError
+	require.True(t, errors.As(err, &batchErr))
+	require.Len(t, batchErr.Tasks, 2)
+
+	checkpoint, err := store.Load()
+	require.NoError(t, err)
+	require.Equal(t, 4, checkpoint.Records)
+	require.Equal(t, int64(bytes.Index(testNdjsonDocuments, []byte(`{"id": 5`))), checkpoint.Offset)
+	require.Equal(t, []int64{batchErr.Tasks[0].UID, batchErr.Tasks[1].UID}, checkpoint.TaskUIDs)
+
+	gotResp, err = i.AddDocumentsNdjsonFromReaderInBatchesWithCheckpoint(context.Background(), bytes.NewReader(testNdjsonDocuments), 2, store)
+	require.NoError(t, err)
+	require.Len(t, gotResp, 1)
+
+	var documentsAdded []map[string]interface{}
+	err = i.GetDocuments(&DocumentsRequest{}, &documentsAdded)
+	require.NoError(t, err)
+	require.Equal(t, testParseNdjsonDocuments(t, bytes.NewReader(testNdjsonDocuments)), documentsAdded)
+}
+
+func TestIndex_AddDocumentsCsvFromReaderInBatchesWithCheckpoint(t *testing.T) {
+	c := defaultClient
+	i := c.Index("TestIndexCsvWithCheckpoint")
+	t.Cleanup(cleanup(c))
+
+	store := NewFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoint.json"))
+	require.NoError(t, store.Save(&Checkpoint{Records: 3, Offset: -1, TaskUIDs: []int64{0, 1}}))
+
+	gotResp, err := i.AddDocumentsCsvFromReaderInBatchesWithCheckpoint(context.Background(), bytes.NewReader(testCsvDocuments), 2, store)
+	require.NoError(t, err)
+	require.Len(t, gotResp, 1)
+
+	checkpoint, err := store.Load()
+	require.NoError(t, err)
+	require.Nil(t, checkpoint)
+
+	var documents []map[string]interface{}
+	err = i.GetDocuments(&DocumentsRequest{}, &documents)
+	require.NoError(t, err)
+	require.Equal(t, testParseCsvDocuments(t, bytes.NewReader(testCsvDocuments))[3:], documents)
+}
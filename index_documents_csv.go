@@ -0,0 +1,231 @@
+package meilisearch
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CsvColumnType is the type of a CSV column, declared in the header with the
+// Meilisearch typed header syntax (e.g. "price:number"). Untyped columns hold
+// strings.
+type CsvColumnType string
+
+const (
+	CsvString  CsvColumnType = "string"
+	CsvNumber  CsvColumnType = "number"
+	CsvBoolean CsvColumnType = "boolean"
+)
+
+// CsvOptions describe how a CSV input is read and transformed before being
+// sent to Meilisearch.
+type CsvOptions struct {
+	// Delimiter is the field delimiter, ',' if zero (e.g. '\t' for TSV or ';')
+	Delimiter rune
+
+	// LazyQuotes allows quotes in unquoted fields and non-doubled quotes in
+	// quoted fields, like csv.Reader.LazyQuotes
+	LazyQuotes bool
+
+	// Rename maps columns of the input header to the field names to use
+	Rename map[string]string
+
+	// Exclude lists columns of the input header to drop
+	Exclude []string
+
+	// Types declares the types of the columns by field name (after renaming).
+	// Column values are checked against their type and the header is
+	// rewritten with the typed header syntax. Types of fields missing from
+	// the input are ignored, so Types can be generated from a struct with
+	// CsvColumnTypesOf.
+	Types map[string]CsvColumnType
+
+	// OnMalformedRow is called with each row that can not be parsed, has the
+	// wrong number of fields or holds a value not matching its type. If set,
+	// malformed rows are skipped and reported instead of making the whole
+	// upload fail.
+	OnMalformedRow func(err *CsvRowError)
+}
+
+// CsvColumnTypesOf returns the CSV column types of the fields of the struct v,
+// named like encoding/json names them: numbers are typed CsvNumber, booleans
+// CsvBoolean and strings CsvString. Other fields are not typed.
+func CsvColumnTypesOf(v interface{}) (map[string]CsvColumnType, error) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, errors.Errorf("could not get CSV column types of %T: not a struct", v)
+	}
+
+	types := make(map[string]CsvColumnType)
+	addCsvColumnTypes(t, types)
+	return types, nil
+}
+
+var jsonNumberType = reflect.TypeOf(json.Number(""))
+
+func addCsvColumnTypes(t reflect.Type, types map[string]CsvColumnType) {
+	for j := 0; j < t.NumField(); j++ {
+		field := t.Field(j)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		// Fields of untagged embedded structs are promoted
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			addCsvColumnTypes(fieldType, types)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		switch fieldType.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			types[name] = CsvNumber
+		case reflect.Bool:
+			types[name] = CsvBoolean
+		case reflect.String:
+			if fieldType == jsonNumberType {
+				types[name] = CsvNumber
+			} else {
+				types[name] = CsvString
+			}
+		}
+	}
+}
+
+// csvHeader is the header of a CSV input transformed according to CsvOptions
+type csvHeader struct {
+	// record is the header record sent to Meilisearch
+	record []string
+	// columns are the indexes of the input columns kept, in order
+	columns []int
+	// types are the types of the kept columns, empty if untyped
+	types []CsvColumnType
+}
+
+func newCsvHeader(record []string, options *CsvOptions) (*csvHeader, error) {
+	input := make(map[string]bool, len(record))
+	for _, name := range record {
+		input[name] = true
+	}
+	for name := range options.Rename {
+		if !input[name] {
+			return nil, errors.Errorf("could not rename CSV column %q: no such column", name)
+		}
+	}
+	excluded := make(map[string]bool, len(options.Exclude))
+	for _, name := range options.Exclude {
+		if !input[name] {
+			return nil, errors.Errorf("could not exclude CSV column %q: no such column", name)
+		}
+		excluded[name] = true
+	}
+
+	h := &csvHeader{}
+	for j, name := range record {
+		if excluded[name] {
+			continue
+		}
+		if rename, ok := options.Rename[name]; ok {
+			name = rename
+		}
+		columnType := options.Types[name]
+		switch columnType {
+		case "":
+		case CsvString, CsvNumber, CsvBoolean:
+			// Replace a type already declared in the input
+			if k := strings.LastIndexByte(name, ':'); k >= 0 {
+				name = name[:k]
+			}
+			name += ":" + string(columnType)
+		default:
+			return nil, errors.Errorf("invalid type %q for CSV column %q", columnType, name)
+		}
+		h.record = append(h.record, name)
+		h.columns = append(h.columns, j)
+		h.types = append(h.types, columnType)
+	}
+	return h, nil
+}
+
+// convert returns the fields of record to send, or a *CsvRowError if a value
+// does not match the type of its column. r is the reader record was read
+// from, used to locate errors.
+func (h *csvHeader) convert(r *csv.Reader, record []string) ([]string, *CsvRowError) {
+	converted := make([]string, len(h.columns))
+	for j, column := range h.columns {
+		value := record[column]
+		if err := checkCsvValue(value, h.types[j]); err != nil {
+			line, _ := r.FieldPos(column)
+			return nil, &CsvRowError{
+				Line: line,
+				Err:  errors.Wrapf(err, "invalid value for column %q", h.record[j]),
+			}
+		}
+		converted[j] = value
+	}
+	return converted, nil
+}
+
+func checkCsvValue(value string, columnType CsvColumnType) error {
+	// Empty values are null whatever the type
+	if value == "" {
+		return nil
+	}
+	switch columnType {
+	case CsvNumber:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return errors.Errorf("%q is not a number", value)
+		}
+	case CsvBoolean:
+		if value != "true" && value != "false" {
+			return errors.Errorf("%q is not a boolean", value)
+		}
+	}
+	return nil
+}
+
+// AddDocumentsCsvFromReaderInBatchesWithOptions works like
+// AddDocumentsCsvFromReaderInBatches but reads the input and transforms its
+// header according to options.
+// If options.OnMalformedRow is set, malformed rows are reported to it and
+// skipped, the other rows are still added.
+func (i Index) AddDocumentsCsvFromReaderInBatchesWithOptions(documents io.Reader, batchSize int, options *CsvOptions, primaryKey ...string) (resp []Task, err error) {
+	var responses []Task
+
+	batchErr := readCsvBatches(documents, batchSize, 0, options, func(batch *documentsBatch) error {
+		resp, err := i.AddDocumentsCsv(batch.payload, primaryKey...)
+		if err != nil {
+			return err
+		}
+		responses = append(responses, *resp)
+		return nil
+	})
+	if batchErr != nil {
+		batchErr.Tasks = responses
+		batchErr.BatchIndex = len(responses)
+		return nil, batchErr
+	}
+
+	return responses, nil
+}
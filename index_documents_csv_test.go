@@ -0,0 +1,155 @@
+package meilisearch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type csvBookTest struct {
+	ID        int         `json:"id"`
+	Title     string      `json:"title"`
+	Price     json.Number `json:"price"`
+	Available *bool       `json:"available"`
+	Tags      []string    `json:"tags"`
+	Internal  string      `json:"-"`
+	csvBookTestEmbedded
+}
+
+type csvBookTestEmbedded struct {
+	Rating float64
+}
+
+func TestCsvColumnTypesOf(t *testing.T) {
+	got, err := CsvColumnTypesOf(&csvBookTest{})
+	require.NoError(t, err)
+	require.Equal(t, map[string]CsvColumnType{
+		"id":        CsvNumber,
+		"title":     CsvString,
+		"price":     CsvNumber,
+		"available": CsvBoolean,
+		"Rating":    CsvNumber,
+	}, got)
+
+	_, err = CsvColumnTypesOf("not a struct")
+	require.Error(t, err)
+}
+
+func TestIndex_AddDocumentsCsvFromReaderInBatchesWithOptions(t *testing.T) {
+	types, err := CsvColumnTypesOf(csvBookTest{})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		documents string
+		options   *CsvOptions
+		wantDocs  []map[string]interface{}
+		wantRows  []int
+	}{
+		{
+			name:      "TestIndexTsv",
+			documents: "id\ttitle\n1\tAlice In Wonderland\n2\tLe Petit Prince\n3\tPride and Prejudice\n",
+			options:   &CsvOptions{Delimiter: '\t'},
+			wantDocs: []map[string]interface{}{
+				{"id": "1", "title": "Alice In Wonderland"},
+				{"id": "2", "title": "Le Petit Prince"},
+				{"id": "3", "title": "Pride and Prejudice"},
+			},
+		},
+		{
+			name:      "TestIndexRenameExcludeTypes",
+			documents: "ID;Name;price;available;secret\n1;Alice In Wonderland;12.5;true;x\n2;Le Petit Prince;8;false;y\n3;Pride and Prejudice;;;z\n",
+			options: &CsvOptions{
+				Delimiter: ';',
+				Rename:    map[string]string{"ID": "id", "Name": "title"},
+				Exclude:   []string{"secret"},
+				Types:     types,
+			},
+			wantDocs: []map[string]interface{}{
+				{"id": float64(1), "title": "Alice In Wonderland", "price": 12.5, "available": true},
+				{"id": float64(2), "title": "Le Petit Prince", "price": float64(8), "available": false},
+				{"id": float64(3), "title": "Pride and Prejudice", "price": nil, "available": false},
+			},
+		},
+		{
+			name:      "TestIndexMalformedRows",
+			documents: "id,title,price\n1,Alice In Wonderland,12.5\n2,Le Petit Prince\n3,\"Pride\" and Prejudice,5\n4,Hamlet,cheap\n5,Don Quixote,3\n",
+			options:   &CsvOptions{Types: types},
+			wantDocs: []map[string]interface{}{
+				{"id": float64(1), "title": "Alice In Wonderland", "price": 12.5},
+				{"id": float64(5), "title": "Don Quixote", "price": float64(3)},
+			},
+			wantRows: []int{1, 2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := defaultClient
+			i := c.Index(tt.name)
+			t.Cleanup(cleanup(c))
+
+			var gotRows []int
+			if tt.wantRows != nil {
+				tt.options.OnMalformedRow = func(err *CsvRowError) {
+					gotRows = append(gotRows, err.Record)
+				}
+			}
+
+			gotResp, err := i.AddDocumentsCsvFromReaderInBatchesWithOptions(strings.NewReader(tt.documents), 2, tt.options, "id")
+			require.NoError(t, err)
+			testWaitForBatchTask(t, i, gotResp)
+			require.Equal(t, tt.wantRows, gotRows)
+
+			var documents []map[string]interface{}
+			err = i.GetDocuments(&DocumentsRequest{}, &documents)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantDocs, documents)
+		})
+	}
+}
+
+func TestIndex_AddDocumentsCsvFromReaderInBatchesWithOptionsError(t *testing.T) {
+	c := defaultClient
+	t.Cleanup(cleanup(c))
+
+	t.Run("TestIndexMalformedRow", func(t *testing.T) {
+		i := c.Index("TestIndexAddDocumentsCsvWithOptionsMalformedRow")
+		documents := []byte("id,price\n1,12.5\n2,8\n3,cheap\n")
+
+		gotResp, err := i.AddDocumentsCsvFromReaderInBatchesWithOptions(bytes.NewReader(documents), 2, &CsvOptions{
+			Types: map[string]CsvColumnType{"price": CsvNumber},
+		})
+		require.Error(t, err)
+		require.Nil(t, gotResp)
+
+		var batchErr *BatchError
+		require.True(t, errors.As(err, &batchErr))
+		require.Len(t, batchErr.Tasks, 1)
+		require.Equal(t, 2, batchErr.Start)
+		require.Equal(t, 3, batchErr.End)
+
+		var rowErr *CsvRowError
+		require.True(t, errors.As(err, &rowErr))
+		require.Equal(t, 2, rowErr.Record)
+		require.Equal(t, 4, rowErr.Line)
+
+		testWaitForBatchTask(t, i, batchErr.Tasks)
+	})
+
+	t.Run("TestIndexUnknownColumn", func(t *testing.T) {
+		i := c.Index("TestIndexAddDocumentsCsvWithOptionsUnknownColumn")
+		documents := []byte("id,name\n1,Alice In Wonderland\n")
+
+		gotResp, err := i.AddDocumentsCsvFromReaderInBatchesWithOptions(bytes.NewReader(documents), 2, &CsvOptions{
+			Exclude: []string{"title"},
+		})
+		require.Error(t, err)
+		require.Nil(t, gotResp)
+		require.Contains(t, err.Error(), `could not exclude CSV column "title"`)
+	})
+}
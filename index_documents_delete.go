@@ -0,0 +1,75 @@
+package meilisearch
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// DeleteDocumentsByFilter deletes the documents matching filter, a filter
+// expression like the Filter of a SearchRequest (e.g. "tenant = 42"). The
+// attributes used in filter must be filterable.
+// It requires Meilisearch v1.2 or later, an *UnsupportedError is returned by
+// older servers.
+func (i Index) DeleteDocumentsByFilter(filter interface{}) (resp *Task, err error) {
+	resp = &Task{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/documents/delete",
+		method:              http.MethodPost,
+		contentType:         contentTypeJSON,
+		withRequest:         &DeleteDocumentsByFilterRequest{Filter: filter},
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "DeleteDocumentsByFilter",
+	}
+	if err := i.client.executeRequest(req); err != nil {
+		return nil, unsupportedError(err, "DeleteDocumentsByFilter", "v1.2")
+	}
+	return resp, nil
+}
+
+// DeleteDocumentsFromReader deletes the documents whose identifiers are read
+// from identifiers, one per line, batchSize at a time. Empty lines are
+// skipped.
+// Identifiers are read and deleted continuously to avoid reading them all
+// into memory. Like DeleteDocumentsFromChannel, the tasks of the batches
+// enqueued are always returned, along with a *BatchError if a batch failed,
+// identifiers could not be read or ctx is done, with Start and End counting
+// identifiers.
+func (i Index) DeleteDocumentsFromReader(ctx context.Context, identifiers io.Reader, batchSize int) (resp []Task, err error) {
+	scanner := bufio.NewScanner(identifiers)
+	next := func() (string, bool, error) {
+		for scanner.Scan() {
+			if identifier := strings.TrimSpace(scanner.Text()); identifier != "" {
+				return identifier, true, nil
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return "", false, errors.Wrap(err, "could not read identifiers")
+		}
+		return "", false, nil
+	}
+	return sendInBatches(ctx, batchSize, next, i.DeleteDocuments)
+}
+
+// DeleteDocumentsFromChannel deletes the documents whose identifiers are
+// received from identifiers, batchSize at a time, until identifiers is closed
+// or ctx is done. Like AddDocumentsFromChannel, the tasks of the batches
+// enqueued are always returned, along with a *BatchError if a batch failed or
+// ctx is done before identifiers is closed, in which case the identifiers of
+// the batch being assembled are not sent.
+func (i Index) DeleteDocumentsFromChannel(ctx context.Context, identifiers <-chan string, batchSize int) (resp []Task, err error) {
+	next := func() (string, bool, error) {
+		select {
+		case <-ctx.Done():
+			return "", false, ctx.Err()
+		case identifier, ok := <-identifiers:
+			return identifier, ok, nil
+		}
+	}
+	return sendInBatches(ctx, batchSize, next, i.DeleteDocuments)
+}
@@ -0,0 +1,173 @@
+package meilisearch
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testAddDeletableDocuments(t *testing.T, i *Index) {
+	documents := []map[string]interface{}{
+		{"id": "1", "tenant": 42},
+		{"id": "2", "tenant": 42},
+		{"id": "3", "tenant": 7},
+		{"id": "4", "tenant": 7},
+		{"id": "5", "tenant": 7},
+	}
+	task, err := i.AddDocuments(documents, "id")
+	require.NoError(t, err)
+	testWaitForTask(t, i, task)
+}
+
+func testDocumentIDs(t *testing.T, i *Index) []string {
+	var documents []docTest
+	err := i.GetDocuments(&DocumentsRequest{}, &documents)
+	require.NoError(t, err)
+	ids := make([]string, len(documents))
+	for j, document := range documents {
+		ids[j] = document.ID
+	}
+	return ids
+}
+
+func TestIndex_DeleteDocumentsByFilter(t *testing.T) {
+	c := defaultClient
+	i := c.Index("TestIndexDeleteDocumentsByFilter")
+	t.Cleanup(cleanup(c))
+
+	testAddDeletableDocuments(t, i)
+	task, err := i.UpdateFilterableAttributes(&[]string{"tenant"})
+	require.NoError(t, err)
+	testWaitForTask(t, i, task)
+
+	task, err = i.DeleteDocumentsByFilter("tenant = 42")
+	require.NoError(t, err)
+	testWaitForTask(t, i, task)
+
+	require.Equal(t, []string{"3", "4", "5"}, testDocumentIDs(t, i))
+}
+
+func TestIndex_DeleteDocumentsByFilterUnsupported(t *testing.T) {
+	// No route is known below an unknown path prefix, like the route of
+	// DeleteDocumentsByFilter by servers older than v1.2
+	c := NewClient(ClientConfig{
+		Host:   "http://localhost:7700/unknown",
+		APIKey: masterKey,
+	})
+	i := c.Index("TestIndexDeleteDocumentsByFilterUnsupported")
+
+	gotResp, err := i.DeleteDocumentsByFilter("tenant = 42")
+	require.Nil(t, gotResp)
+	require.True(t, errors.Is(err, ErrUnsupportedByServer))
+	var unsupportedErr *UnsupportedError
+	require.True(t, errors.As(err, &unsupportedErr))
+	require.Equal(t, "v1.2", unsupportedErr.MinVersion)
+	require.Contains(t, err.Error(), "DeleteDocumentsByFilter is unsupported by this server, it requires Meilisearch v1.2 or later: ")
+	var apiErr *Error
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, 404, apiErr.StatusCode)
+}
+
+func TestIndex_DeleteDocumentsFromReader(t *testing.T) {
+	c := defaultClient
+	i := c.Index("TestIndexDeleteDocumentsFromReader")
+	t.Cleanup(cleanup(c))
+
+	testAddDeletableDocuments(t, i)
+	gotResp, err := i.DeleteDocumentsFromReader(context.Background(), strings.NewReader("1\n\n 2\n3\r\n"), 2)
+	require.NoError(t, err)
+	require.Len(t, gotResp, 2)
+	testWaitForBatchTask(t, i, gotResp)
+
+	require.Equal(t, []string{"4", "5"}, testDocumentIDs(t, i))
+}
+
+func TestIndex_DeleteDocumentsFromReaderError(t *testing.T) {
+	c := defaultClient
+	i := c.Index("TestIndexDeleteDocumentsFromReaderError")
+	t.Cleanup(cleanup(c))
+
+	testAddDeletableDocuments(t, i)
+	identifiers := io.MultiReader(
+		bytes.NewReader([]byte("1\n2\n3\n")),
+		iotest.ErrReader(errors.New("connection reset")),
+	)
+	gotResp, err := i.DeleteDocumentsFromReader(context.Background(), identifiers, 2)
+	require.Error(t, err)
+	require.Len(t, gotResp, 1)
+
+	var batchErr *BatchError
+	require.True(t, errors.As(err, &batchErr))
+	require.Equal(t, gotResp, batchErr.Tasks)
+	require.Equal(t, 1, batchErr.BatchIndex)
+	require.Equal(t, 2, batchErr.Start)
+	require.Equal(t, 4, batchErr.End)
+	testWaitForBatchTask(t, i, gotResp)
+
+	require.Equal(t, []string{"3", "4", "5"}, testDocumentIDs(t, i))
+}
+
+func TestIndex_DeleteDocumentsFromReaderInvalidBatchSize(t *testing.T) {
+	i := defaultClient.Index("TestIndexDeleteDocumentsFromReaderInvalidBatchSize")
+
+	gotResp, err := i.DeleteDocumentsFromReader(context.Background(), strings.NewReader("1\n2\n"), 0)
+	require.EqualError(t, err, "invalid batch size 0, must be positive")
+	require.Empty(t, gotResp)
+
+	identifiers := make(chan string)
+	close(identifiers)
+	gotResp, err = i.DeleteDocumentsFromChannel(context.Background(), identifiers, -1)
+	require.EqualError(t, err, "invalid batch size -1, must be positive")
+	require.Empty(t, gotResp)
+}
+
+func TestIndex_DeleteDocumentsFromChannel(t *testing.T) {
+	c := defaultClient
+	i := c.Index("TestIndexDeleteDocumentsFromChannel")
+	t.Cleanup(cleanup(c))
+
+	testAddDeletableDocuments(t, i)
+	identifiers := make(chan string)
+	go func() {
+		defer close(identifiers)
+		for _, identifier := range []string{"1", "3", "5"} {
+			identifiers <- identifier
+		}
+	}()
+
+	gotResp, err := i.DeleteDocumentsFromChannel(context.Background(), identifiers, 2)
+	require.NoError(t, err)
+	require.Len(t, gotResp, 2)
+	testWaitForBatchTask(t, i, gotResp)
+
+	require.Equal(t, []string{"2", "4"}, testDocumentIDs(t, i))
+}
+
+func TestIndex_DeleteDocumentsFromChannelCanceled(t *testing.T) {
+	c := defaultClient
+	i := c.Index("TestIndexDeleteDocumentsFromChannelCanceled")
+	t.Cleanup(cleanup(c))
+
+	testAddDeletableDocuments(t, i)
+	ctx, cancel := context.WithCancel(context.Background())
+	identifiers := make(chan string)
+	go func() {
+		identifiers <- "1"
+		identifiers <- "2"
+		identifiers <- "3"
+		cancel()
+	}()
+
+	gotResp, err := i.DeleteDocumentsFromChannel(ctx, identifiers, 2)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Len(t, gotResp, 1)
+	testWaitForBatchTask(t, i, gotResp)
+
+	require.Equal(t, []string{"3", "4", "5"}, testDocumentIDs(t, i))
+}
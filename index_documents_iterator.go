@@ -0,0 +1,208 @@
+package meilisearch
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultDocumentsPageSize is the number of documents fetched at a time by a
+// DocumentsIterator when no page size is given
+const DefaultDocumentsPageSize = 1000
+
+// DocumentsIteratorOptions configure how a DocumentsIterator pages through
+// the documents of an index.
+type DocumentsIteratorOptions struct {
+	// PageSize is the number of documents fetched at a time,
+	// DefaultDocumentsPageSize if zero
+	PageSize int64
+
+	// Fields are the only fields retrieved, all fields are retrieved if empty
+	Fields []string
+
+	// Filter restricts the iteration to the documents matching a filter
+	// expression, all documents are iterated over if empty. It requires
+	// Meilisearch v1.2 or later.
+	Filter string
+
+	// Prefetch fetches the next page in the background while the current one
+	// is iterated over
+	Prefetch bool
+}
+
+// DocumentsIterator iterates over all the documents of an index, fetching
+// them page by page:
+//
+//	it := index.Documents(ctx, nil)
+//	for it.Next() {
+//		var book Book
+//		if err := it.Decode(&book); err != nil {
+//			...
+//		}
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type DocumentsIterator struct {
+	ctx     context.Context
+	index   Index
+	options DocumentsIteratorOptions
+
+	page     []json.RawMessage
+	position int
+	// offset of the next page to fetch
+	offset int64
+	// last is set once the last page is fetched
+	last bool
+	// prefetched receives the next page when prefetching
+	prefetched chan documentsPage
+
+	document json.RawMessage
+	err      error
+}
+
+type documentsPage struct {
+	documents []json.RawMessage
+	err       error
+}
+
+// Documents returns an iterator over all the documents of the index,
+// configured by options which may be nil. The iteration stops as soon as ctx
+// is done.
+// Documents added or deleted during the iteration may be skipped or returned
+// twice, since pages are fetched by offset.
+func (i Index) Documents(ctx context.Context, options *DocumentsIteratorOptions) *DocumentsIterator {
+	it := &DocumentsIterator{
+		ctx:      ctx,
+		index:    i,
+		position: -1,
+	}
+	if options != nil {
+		it.options = *options
+	}
+	if it.options.PageSize <= 0 {
+		it.options.PageSize = DefaultDocumentsPageSize
+	}
+	return it
+}
+
+// Next advances to the next document, fetching the next page if needed. It
+// returns false at the end of the documents, when ctx is done or when a page
+// could not be fetched, see Err.
+func (it *DocumentsIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+
+	it.position++
+	if it.position >= len(it.page) {
+		if it.last {
+			it.document = nil
+			return false
+		}
+		if !it.nextPage() {
+			return false
+		}
+	}
+	it.document = it.page[it.position]
+	return true
+}
+
+// nextPage replaces the current page with the next one
+func (it *DocumentsIterator) nextPage() bool {
+	var page documentsPage
+	if it.prefetched != nil {
+		select {
+		case page = <-it.prefetched:
+		case <-it.ctx.Done():
+			it.err = it.ctx.Err()
+			return false
+		}
+	} else {
+		page = it.fetch(it.offset)
+	}
+	it.prefetched = nil
+	if page.err != nil {
+		it.err = page.err
+		return false
+	}
+
+	it.page = page.documents
+	it.position = 0
+	it.offset += int64(len(page.documents))
+	it.last = int64(len(page.documents)) < it.options.PageSize
+	if len(page.documents) == 0 {
+		return false
+	}
+
+	if it.options.Prefetch && !it.last {
+		// Buffered so that the fetch never blocks if the iteration stops
+		it.prefetched = make(chan documentsPage, 1)
+		go func(prefetched chan<- documentsPage, offset int64) {
+			prefetched <- it.fetch(offset)
+		}(it.prefetched, it.offset)
+	}
+	return true
+}
+
+func (it *DocumentsIterator) fetch(offset int64) documentsPage {
+	var documents []json.RawMessage
+	err := it.index.GetDocuments(&DocumentsRequest{
+		Offset:               offset,
+		Limit:                it.options.PageSize,
+		AttributesToRetrieve: it.options.Fields,
+		Filter:               it.options.Filter,
+	}, &documents)
+	if err != nil {
+		return documentsPage{err: errors.Wrapf(err, "could not fetch documents from offset %d", offset)}
+	}
+	return documentsPage{documents: documents}
+}
+
+// Raw returns the JSON encoding of the current document
+func (it *DocumentsIterator) Raw() json.RawMessage {
+	return it.document
+}
+
+// Decode decodes the current document into documentPtr
+func (it *DocumentsIterator) Decode(documentPtr interface{}) error {
+	if it.document == nil {
+		return errors.New("no current document, Next must be called first")
+	}
+	return json.Unmarshal(it.document, documentPtr)
+}
+
+// Err returns the error that stopped the iteration, ctx.Err() if ctx is done,
+// or nil at the end of the documents
+func (it *DocumentsIterator) Err() error {
+	return it.err
+}
+
+// DocumentsAs returns an iter.Seq2 over all the documents of index decoded as
+// Ts, configured by options like Index.Documents. The error that stopped the
+// iteration, if any, is yielded last with a zero T.
+func DocumentsAs[T any](ctx context.Context, index *Index, options *DocumentsIteratorOptions) func(yield func(T, error) bool) {
+	return func(yield func(T, error) bool) {
+		it := index.Documents(ctx, options)
+		for it.Next() {
+			var document T
+			if err := it.Decode(&document); err != nil {
+				var zero T
+				yield(zero, errors.Wrap(err, "could not decode document"))
+				return
+			}
+			if !yield(document, nil) {
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
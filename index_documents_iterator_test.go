@@ -0,0 +1,146 @@
+package meilisearch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testAddIteratedDocuments(t *testing.T, i *Index) []docTestBooks {
+	documents := []docTestBooks{
+		{BookID: 1, Title: "Alice In Wonderland", Tag: "Tale", Year: 1865},
+		{BookID: 2, Title: "Pride and Prejudice", Tag: "Romance", Year: 1813},
+		{BookID: 3, Title: "Le Petit Prince", Tag: "Tale", Year: 1943},
+		{BookID: 4, Title: "Harry Potter and the Half-Blood Prince", Tag: "Fantasy", Year: 2005},
+		{BookID: 5, Title: "The Hobbit", Tag: "Fantasy", Year: 1937},
+	}
+	task, err := i.AddDocuments(documents, "book_id")
+	require.NoError(t, err)
+	testWaitForTask(t, i, task)
+	return documents
+}
+
+func TestIndex_Documents(t *testing.T) {
+	c := defaultClient
+	i := c.Index("TestIndexDocuments")
+	t.Cleanup(cleanup(c))
+	documents := testAddIteratedDocuments(t, i)
+
+	tests := []struct {
+		name    string
+		options *DocumentsIteratorOptions
+		want    []docTestBooks
+	}{
+		{
+			name: "TestIndexDocumentsDefault",
+			want: documents,
+		},
+		{
+			name:    "TestIndexDocumentsPages",
+			options: &DocumentsIteratorOptions{PageSize: 2},
+			want:    documents,
+		},
+		{
+			name:    "TestIndexDocumentsExactPages",
+			options: &DocumentsIteratorOptions{PageSize: 5, Prefetch: true},
+			want:    documents,
+		},
+		{
+			name:    "TestIndexDocumentsPrefetch",
+			options: &DocumentsIteratorOptions{PageSize: 2, Prefetch: true},
+			want:    documents,
+		},
+		{
+			name:    "TestIndexDocumentsFields",
+			options: &DocumentsIteratorOptions{PageSize: 3, Fields: []string{"book_id"}},
+			want:    []docTestBooks{{BookID: 1}, {BookID: 2}, {BookID: 3}, {BookID: 4}, {BookID: 5}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []docTestBooks
+			it := i.Documents(context.Background(), tt.options)
+			for it.Next() {
+				require.NotEmpty(t, it.Raw())
+				var document docTestBooks
+				require.NoError(t, it.Decode(&document))
+				got = append(got, document)
+			}
+			require.NoError(t, it.Err())
+			require.Equal(t, tt.want, got)
+			require.False(t, it.Next())
+		})
+	}
+}
+
+func TestIndex_GetDocumentsFilter(t *testing.T) {
+	c := defaultClient
+	i := c.Index("TestIndexGetDocumentsFilter")
+	t.Cleanup(cleanup(c))
+	documents := testAddIteratedDocuments(t, i)
+	task, err := i.UpdateFilterableAttributes(&[]string{"tag"})
+	require.NoError(t, err)
+	testWaitForTask(t, i, task)
+
+	var got []docTestBooks
+	err = i.GetDocuments(&DocumentsRequest{Offset: 1, Limit: 1, Filter: "tag = Tale"}, &got)
+	require.NoError(t, err)
+	require.Equal(t, []docTestBooks{documents[2]}, got)
+
+	// Servers older than v1.2 ignore the filter of the documents route, no
+	// route is known below an unknown path prefix either
+	old := NewClient(ClientConfig{
+		Host:   "http://localhost:7700/unknown",
+		APIKey: masterKey,
+	})
+	err = old.Index(i.UID).GetDocuments(&DocumentsRequest{Filter: "tag = Tale"}, &got)
+	require.ErrorIs(t, err, ErrUnsupportedByServer)
+	require.Contains(t, err.Error(), "GetDocuments with a filter is unsupported by this server, it requires Meilisearch v1.2 or later")
+}
+
+func TestIndex_DocumentsCanceled(t *testing.T) {
+	c := defaultClient
+	i := c.Index("TestIndexDocumentsCanceled")
+	t.Cleanup(cleanup(c))
+	testAddIteratedDocuments(t, i)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	it := i.Documents(ctx, &DocumentsIteratorOptions{PageSize: 2, Prefetch: true})
+	require.True(t, it.Next())
+	require.True(t, it.Next())
+	cancel()
+	require.False(t, it.Next())
+	require.ErrorIs(t, it.Err(), context.Canceled)
+}
+
+func TestIndex_DocumentsError(t *testing.T) {
+	c := defaultClient
+	it := c.Index("TestIndexDocumentsError").Documents(context.Background(), nil)
+	require.False(t, it.Next())
+	require.ErrorIs(t, it.Err(), ErrIndexNotFound)
+	require.Error(t, it.Decode(&docTestBooks{}))
+}
+
+func TestDocumentsAs(t *testing.T) {
+	c := defaultClient
+	i := c.Index("TestDocumentsAs")
+	t.Cleanup(cleanup(c))
+	documents := testAddIteratedDocuments(t, i)
+
+	var got []docTestBooks
+	DocumentsAs[docTestBooks](context.Background(), i, &DocumentsIteratorOptions{PageSize: 2})(func(document docTestBooks, err error) bool {
+		require.NoError(t, err)
+		got = append(got, document)
+		// Stop early
+		return len(got) < 3
+	})
+	require.Equal(t, documents[:3], got)
+
+	var gotErr error
+	DocumentsAs[docTestBooks](context.Background(), c.Index("TestDocumentsAsMissing"), nil)(func(_ docTestBooks, err error) bool {
+		gotErr = err
+		return true
+	})
+	require.ErrorIs(t, gotErr, ErrIndexNotFound)
+}
@@ -0,0 +1,103 @@
+package meilisearch
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// sqlNumericTypes are the database type names of the columns holding numbers
+// that drivers may return as text, e.g. NUMERIC with lib/pq or any integer
+// with the MySQL text protocol
+var sqlNumericTypes = map[string]bool{
+	"INT": true, "INTEGER": true, "TINYINT": true, "SMALLINT": true, "MEDIUMINT": true, "BIGINT": true,
+	"INT2": true, "INT4": true, "INT8": true, "SERIAL": true, "BIGSERIAL": true,
+	"UNSIGNED INT": true, "UNSIGNED TINYINT": true, "UNSIGNED SMALLINT": true,
+	"UNSIGNED MEDIUMINT": true, "UNSIGNED BIGINT": true,
+	"NUMERIC": true, "DECIMAL": true, "FLOAT": true, "FLOAT4": true, "FLOAT8": true, "DOUBLE": true, "REAL": true,
+}
+
+// sqlJSONTypes are the database type names of the columns holding JSON
+var sqlJSONTypes = map[string]bool{
+	"JSON": true, "JSONB": true,
+}
+
+// AddDocumentsFromRows adds every row of rows as a document, batchSize rows
+// at a time, and closes rows.
+// Column names are used as field names, use aliases in the query to rename
+// them. NULL values become null, times are encoded in RFC 3339 format,
+// numbers returned as text by the driver (e.g. NUMERIC) are kept as exact
+// JSON numbers and JSON columns are embedded as objects.
+// Like AddDocumentsFromChannel, the tasks of the batches enqueued are always
+// returned, along with a *BatchError if a batch failed or a row could not be
+// read.
+func (i Index) AddDocumentsFromRows(rows *sql.Rows, batchSize int, primaryKey ...string) (resp []Task, err error) {
+	defer rows.Close()
+
+	columns, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read columns")
+	}
+
+	values := make([]interface{}, len(columns))
+	pointers := make([]interface{}, len(columns))
+	for j := range values {
+		pointers[j] = &values[j]
+	}
+
+	next := func() (map[string]interface{}, bool, error) {
+		if !rows.Next() {
+			if err := rows.Err(); err != nil {
+				return nil, false, errors.Wrap(err, "could not read row")
+			}
+			return nil, false, nil
+		}
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, false, errors.Wrap(err, "could not scan row")
+		}
+		document := make(map[string]interface{}, len(columns))
+		for j, column := range columns {
+			document[column.Name()] = convertSQLValue(values[j], column.DatabaseTypeName())
+		}
+		return document, true, nil
+	}
+
+	send := func(batch []map[string]interface{}) (*Task, error) {
+		return i.AddDocuments(batch, primaryKey...)
+	}
+	return sendInBatches(context.Background(), batchSize, next, send)
+}
+
+// AddDocumentsFromQuery runs query with args on db and adds the resulting rows
+// as documents like AddDocumentsFromRows.
+func (i Index) AddDocumentsFromQuery(ctx context.Context, db *sql.DB, query string, args []interface{}, batchSize int, primaryKey ...string) (resp []Task, err error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not run query")
+	}
+	return i.AddDocumentsFromRows(rows, batchSize, primaryKey...)
+}
+
+// convertSQLValue converts a value scanned from a column of type
+// databaseTypeName into a value encoded as expected in a document
+func convertSQLValue(value interface{}, databaseTypeName string) interface{} {
+	b, ok := value.([]byte)
+	if !ok {
+		return value
+	}
+
+	databaseTypeName = strings.ToUpper(databaseTypeName)
+	if sqlNumericTypes[databaseTypeName] {
+		var number json.Number
+		if err := json.Unmarshal(b, &number); err == nil {
+			return number
+		}
+	}
+	if sqlJSONTypes[databaseTypeName] && json.Valid(b) {
+		return json.RawMessage(b)
+	}
+	return string(b)
+}
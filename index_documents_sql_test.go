@@ -0,0 +1,121 @@
+package meilisearch
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testSQLDriver is a database/sql driver returning the same rows for every
+// query, typed like a PostgreSQL driver would type them
+type testSQLDriver struct{}
+
+type testSQLConn struct{}
+
+type testSQLStmt struct{}
+
+type testSQLRows struct {
+	next int
+}
+
+var (
+	testSQLColumns = []string{"id", "title", "price", "published_at", "tags", "rating"}
+	testSQLTypes   = []string{"INT8", "TEXT", "NUMERIC", "TIMESTAMPTZ", "JSONB", "FLOAT8"}
+	testSQLValues  = [][]driver.Value{
+		{int64(1), "Alice In Wonderland", []byte("12.50"), time.Date(1865, 11, 26, 0, 0, 0, 0, time.UTC), []byte(`["tale"]`), 4.5},
+		{int64(2), "Pride and Prejudice", []byte("8"), time.Date(1813, 1, 28, 0, 0, 0, 0, time.UTC), nil, nil},
+		{int64(3), []byte("Le Petit Prince"), nil, nil, []byte(`{"lang":"fr"}`), 5.0},
+	}
+)
+
+func init() {
+	sql.Register("meilisearch-test", testSQLDriver{})
+}
+
+func (testSQLDriver) Open(string) (driver.Conn, error)         { return testSQLConn{}, nil }
+func (testSQLConn) Prepare(string) (driver.Stmt, error)        { return testSQLStmt{}, nil }
+func (testSQLConn) Close() error                               { return nil }
+func (testSQLConn) Begin() (driver.Tx, error)                  { return nil, driver.ErrSkip }
+func (testSQLStmt) Close() error                               { return nil }
+func (testSQLStmt) NumInput() int                              { return -1 }
+func (testSQLStmt) Exec([]driver.Value) (driver.Result, error) { return nil, driver.ErrSkip }
+func (testSQLStmt) Query([]driver.Value) (driver.Rows, error)  { return &testSQLRows{}, nil }
+func (*testSQLRows) Columns() []string                         { return testSQLColumns }
+func (*testSQLRows) Close() error                              { return nil }
+
+func (*testSQLRows) ColumnTypeDatabaseTypeName(index int) string {
+	return testSQLTypes[index]
+}
+
+func (r *testSQLRows) Next(dest []driver.Value) error {
+	if r.next == len(testSQLValues) {
+		return io.EOF
+	}
+	copy(dest, testSQLValues[r.next])
+	r.next++
+	return nil
+}
+
+func TestIndex_AddDocumentsFromQuery(t *testing.T) {
+	c := defaultClient
+	i := c.Index("TestIndexAddDocumentsFromQuery")
+	t.Cleanup(cleanup(c))
+
+	db, err := sql.Open("meilisearch-test", "")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	gotResp, err := i.AddDocumentsFromQuery(context.Background(), db, "SELECT * FROM books", nil, 2, "id")
+	require.NoError(t, err)
+	require.Len(t, gotResp, 2)
+	testWaitForBatchTask(t, i, gotResp)
+
+	var documents []map[string]interface{}
+	err = i.GetDocuments(&DocumentsRequest{}, &documents)
+	require.NoError(t, err)
+	require.Equal(t, []map[string]interface{}{
+		{"id": float64(1), "title": "Alice In Wonderland", "price": 12.5, "published_at": "1865-11-26T00:00:00Z", "tags": []interface{}{"tale"}, "rating": 4.5},
+		{"id": float64(2), "title": "Pride and Prejudice", "price": float64(8), "published_at": "1813-01-28T00:00:00Z", "tags": nil, "rating": nil},
+		{"id": float64(3), "title": "Le Petit Prince", "price": nil, "published_at": nil, "tags": map[string]interface{}{"lang": "fr"}, "rating": float64(5)},
+	}, documents)
+}
+
+func TestIndex_AddDocumentsFromQueryInvalidBatchSize(t *testing.T) {
+	i := defaultClient.Index("TestIndexAddDocumentsFromQueryInvalidBatchSize")
+
+	db, err := sql.Open("meilisearch-test", "")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	gotResp, err := i.AddDocumentsFromQuery(context.Background(), db, "SELECT * FROM books", nil, 0, "id")
+	require.EqualError(t, err, "invalid batch size 0, must be positive")
+	require.Empty(t, gotResp)
+}
+
+func Test_convertSQLValue(t *testing.T) {
+	tests := []struct {
+		name             string
+		value            interface{}
+		databaseTypeName string
+		want             interface{}
+	}{
+		{name: "Null", value: nil, databaseTypeName: "TEXT", want: nil},
+		{name: "Integer", value: int64(42), databaseTypeName: "INT8", want: int64(42)},
+		{name: "Text", value: []byte("Hamlet"), databaseTypeName: "VARCHAR", want: "Hamlet"},
+		{name: "Numeric", value: []byte("1234.5678"), databaseTypeName: "numeric", want: json.Number("1234.5678")},
+		{name: "NumericNaN", value: []byte("NaN"), databaseTypeName: "NUMERIC", want: "NaN"},
+		{name: "JSON", value: []byte(`{"a":1}`), databaseTypeName: "JSON", want: json.RawMessage(`{"a":1}`)},
+		{name: "InvalidJSON", value: []byte(`{"a":`), databaseTypeName: "JSONB", want: `{"a":`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, convertSQLValue(tt.value, tt.databaseTypeName))
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package meilisearch
+
+import "context"
+
+// AddDocumentsFromChannel adds the documents received from documents to
+// index, batchSize at a time, until documents is closed or ctx is done.
+// Batches are sent with the same semantics as AddDocumentsInBatches. The
+// tasks of the batches enqueued are always returned, along with a *BatchError
+// if a batch failed or ctx is done before documents is closed, in which case
+// the documents of the batch being assembled are not sent.
+func AddDocumentsFromChannel[T any](ctx context.Context, index *Index, documents <-chan T, batchSize int, primaryKey ...string) (resp []Task, err error) {
+	next := func() (T, bool, error) {
+		select {
+		case <-ctx.Done():
+			var zero T
+			return zero, false, ctx.Err()
+		case document, ok := <-documents:
+			return document, ok, nil
+		}
+	}
+	send := func(batch []T) (*Task, error) {
+		return index.AddDocuments(batch, primaryKey...)
+	}
+	return sendInBatches(ctx, batchSize, next, send)
+}
+
+// AddDocumentsFromSeq adds the documents yielded by documents, an iter.Seq,
+// to index like AddDocumentsFromChannel. The iteration is stopped as soon as
+// a batch fails or ctx is done.
+func AddDocumentsFromSeq[T any](ctx context.Context, index *Index, documents func(yield func(T) bool), batchSize int, primaryKey ...string) (resp []Task, err error) {
+	// Iterate in a goroutine so that documents can be pulled one by one
+	ch := make(chan T)
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		defer close(ch)
+		documents(func(document T) bool {
+			select {
+			case ch <- document:
+				return true
+			case <-stop:
+				return false
+			}
+		})
+	}()
+	return AddDocumentsFromChannel(ctx, index, ch, batchSize, primaryKey...)
+}
@@ -0,0 +1,122 @@
+package meilisearch
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddDocumentsFromChannel(t *testing.T) {
+	c := defaultClient
+	i := c.Index("TestAddDocumentsFromChannel")
+	t.Cleanup(cleanup(c))
+
+	documents := make(chan docTest)
+	go func() {
+		defer close(documents)
+		documents <- docTest{ID: "1", Name: "Alice In Wonderland"}
+		documents <- docTest{ID: "2", Name: "Pride and Prejudice"}
+		documents <- docTest{ID: "3", Name: "Le Petit Prince"}
+	}()
+
+	gotResp, err := AddDocumentsFromChannel(context.Background(), i, documents, 2, "id")
+	require.NoError(t, err)
+	require.Len(t, gotResp, 2)
+	testWaitForBatchTask(t, i, gotResp)
+
+	var gotDocs []docTest
+	err = i.GetDocuments(&DocumentsRequest{}, &gotDocs)
+	require.NoError(t, err)
+	require.Equal(t, []docTest{
+		{ID: "1", Name: "Alice In Wonderland"},
+		{ID: "2", Name: "Pride and Prejudice"},
+		{ID: "3", Name: "Le Petit Prince"},
+	}, gotDocs)
+}
+
+func TestAddDocumentsFromChannelCanceled(t *testing.T) {
+	c := defaultClient
+	i := c.Index("TestAddDocumentsFromChannelCanceled")
+	t.Cleanup(cleanup(c))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	documents := make(chan docTest)
+	go func() {
+		documents <- docTest{ID: "1", Name: "Alice In Wonderland"}
+		documents <- docTest{ID: "2", Name: "Pride and Prejudice"}
+		documents <- docTest{ID: "3", Name: "Le Petit Prince"}
+		cancel()
+	}()
+
+	gotResp, err := AddDocumentsFromChannel(ctx, i, documents, 2, "id")
+	require.ErrorIs(t, err, context.Canceled)
+	require.Len(t, gotResp, 1)
+
+	var batchErr *BatchError
+	require.True(t, errors.As(err, &batchErr))
+	require.Equal(t, 1, batchErr.BatchIndex)
+	require.Equal(t, 2, batchErr.Start)
+	require.Equal(t, 3, batchErr.End)
+	testWaitForBatchTask(t, i, gotResp)
+}
+
+func TestAddDocumentsFromChannelInvalidBatchSize(t *testing.T) {
+	i := defaultClient.Index("TestAddDocumentsFromChannelInvalidBatchSize")
+
+	documents := make(chan docTest, 1)
+	documents <- docTest{ID: "1", Name: "Alice In Wonderland"}
+	close(documents)
+
+	gotResp, err := AddDocumentsFromChannel(context.Background(), i, documents, 0, "id")
+	require.EqualError(t, err, "invalid batch size 0, must be positive")
+	require.Empty(t, gotResp)
+	require.Len(t, documents, 1)
+
+	seq := func(yield func(docTest) bool) {
+		yield(docTest{ID: "1", Name: "Alice In Wonderland"})
+	}
+	gotResp, err = AddDocumentsFromSeq(context.Background(), i, seq, -1, "id")
+	require.EqualError(t, err, "invalid batch size -1, must be positive")
+	require.Empty(t, gotResp)
+}
+
+func TestAddDocumentsFromSeq(t *testing.T) {
+	c := defaultClient
+	i := c.Index("TestAddDocumentsFromSeq")
+	t.Cleanup(cleanup(c))
+
+	seq := func(yield func(map[string]interface{}) bool) {
+		for id := 1; id <= 5; id++ {
+			if !yield(map[string]interface{}{"id": id, "name": "Book"}) {
+				return
+			}
+		}
+	}
+
+	gotResp, err := AddDocumentsFromSeq(context.Background(), i, seq, 2)
+	require.NoError(t, err)
+	require.Len(t, gotResp, 3)
+	testWaitForBatchTask(t, i, gotResp)
+
+	stats, err := i.GetStats()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), stats.NumberOfDocuments)
+}
+
+func TestAddDocumentsFromSeqStopped(t *testing.T) {
+	i := defaultClient.Index("TestAddDocumentsFromSeqStopped")
+
+	stopped := make(chan bool)
+	seq := func(yield func(map[string]interface{}) bool) {
+		yield(map[string]interface{}{"id": 1, "invalid": func() {}})
+		ok := yield(map[string]interface{}{"id": 2})
+		stopped <- !ok
+	}
+
+	gotResp, err := AddDocumentsFromSeq(context.Background(), i, seq, 1)
+	require.Error(t, err)
+	require.Empty(t, gotResp)
+	require.True(t, <-stopped)
+}
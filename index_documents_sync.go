@@ -0,0 +1,260 @@
+package meilisearch
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// DocumentHashStore stores the content hashes of the documents synchronized
+// by Index.SyncDocuments, keyed by primary key value.
+// A store must only be used for a single index.
+type DocumentHashStore interface {
+	// Hashes returns the hashes of all the documents synchronized so far
+	Hashes() (map[string]string, error)
+	// Update records the hashes of the documents added or changed and
+	// forgets the deleted ones
+	Update(changed map[string]string, deleted []string) error
+}
+
+// SyncResult is the outcome of Index.SyncDocuments
+type SyncResult struct {
+	// Number of documents sent because they were not synchronized before
+	Added int
+	// Number of documents sent because their content changed
+	Updated int
+	// Number of documents deleted because they disappeared from the source
+	Deleted int
+	// Number of documents skipped because their content did not change
+	Unchanged int
+	// Tasks of the updates and deletions, all succeeded
+	Tasks []Task
+}
+
+// SyncDocuments makes the index match documentsPtr, a slice holding the full
+// set of documents of the index, while only sending what changed since the
+// previous synchronization recorded in store: new or changed documents are
+// sent with UpdateDocuments and the documents whose primary key disappeared
+// from documentsPtr are deleted with DeleteDocuments, batchSize at a time.
+// documentsPtr may also be a pointer to such a slice, batchSize must be
+// positive.
+// SyncDocuments waits for all tasks and only records the new hashes in store
+// once they all succeeded, so a failed synchronization can just be run again.
+func (i Index) SyncDocuments(ctx context.Context, documentsPtr interface{}, primaryKey string, batchSize int, store DocumentHashStore) (resp *SyncResult, err error) {
+	if batchSize <= 0 {
+		return nil, errors.Errorf("invalid batch size %d, must be positive", batchSize)
+	}
+	arr := reflect.Indirect(reflect.ValueOf(documentsPtr))
+	if arr.Kind() != reflect.Slice && arr.Kind() != reflect.Array {
+		return nil, errors.Errorf("could not sync documents of type %T: not a slice", documentsPtr)
+	}
+
+	previous, err := store.Hashes()
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &SyncResult{}
+	var (
+		changed   = map[string]string{}
+		seen      = map[string]bool{}
+		documents []json.RawMessage
+	)
+
+	for j := 0; j < arr.Len(); j++ {
+		document, id, hash, err := hashDocument(arr.Index(j).Interface(), primaryKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not hash document %d", j)
+		}
+		if seen[id] {
+			return nil, errors.Errorf("document %d: duplicated primary key %q", j, id)
+		}
+		seen[id] = true
+
+		previousHash, ok := previous[id]
+		switch {
+		case !ok:
+			resp.Added++
+		case previousHash != hash:
+			resp.Updated++
+		default:
+			resp.Unchanged++
+			continue
+		}
+		changed[id] = hash
+		documents = append(documents, document)
+	}
+
+	var deleted []string
+	for id := range previous {
+		if !seen[id] {
+			deleted = append(deleted, id)
+		}
+	}
+	resp.Deleted = len(deleted)
+
+	if len(documents) > 0 {
+		tasks, err := i.UpdateDocumentsInBatches(documents, batchSize, primaryKey)
+		if err != nil {
+			return nil, err
+		}
+		resp.Tasks = append(resp.Tasks, tasks...)
+	}
+	for start := 0; start < len(deleted); start += batchSize {
+		end := start + batchSize
+		if end > len(deleted) {
+			end = len(deleted)
+		}
+		task, err := i.DeleteDocuments(deleted[start:end])
+		if err != nil {
+			return nil, err
+		}
+		resp.Tasks = append(resp.Tasks, *task)
+	}
+
+	for j := range resp.Tasks {
+		if _, err := i.client.waitForTaskSucceeded(ctx, &resp.Tasks[j]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := store.Update(changed, deleted); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// hashDocument returns the JSON encoding of document along with its primary
+// key value and the hash of its content. The hash does not depend on the
+// order of the fields of the document.
+func hashDocument(document interface{}, primaryKey string) (json.RawMessage, string, string, error) {
+	data, err := json.Marshal(document)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	// Decode and encode again so that fields are sorted and numbers keep
+	// their exact representation
+	var fields map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&fields); err != nil {
+		return nil, "", "", errors.Wrap(err, "document is not a JSON object")
+	}
+	canonical, err := json.Marshal(fields)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	var id string
+	switch value := fields[primaryKey].(type) {
+	case string:
+		id = value
+	case json.Number:
+		id = value.String()
+	default:
+		return nil, "", "", errors.Errorf("missing or invalid primary key %q", primaryKey)
+	}
+
+	sum := sha256.Sum256(canonical)
+	return data, id, hex.EncodeToString(sum[:]), nil
+}
+
+// MemoryDocumentHashStore is a DocumentHashStore keeping the hashes in
+// memory, for processes synchronizing an index periodically.
+type MemoryDocumentHashStore struct {
+	mu     sync.Mutex
+	hashes map[string]string
+}
+
+var _ DocumentHashStore = &MemoryDocumentHashStore{}
+
+// NewMemoryDocumentHashStore creates an empty MemoryDocumentHashStore
+func NewMemoryDocumentHashStore() *MemoryDocumentHashStore {
+	return &MemoryDocumentHashStore{
+		hashes: map[string]string{},
+	}
+}
+
+func (s *MemoryDocumentHashStore) Hashes() (map[string]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	hashes := make(map[string]string, len(s.hashes))
+	for id, hash := range s.hashes {
+		hashes[id] = hash
+	}
+	return hashes, nil
+}
+
+func (s *MemoryDocumentHashStore) Update(changed map[string]string, deleted []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, hash := range changed {
+		s.hashes[id] = hash
+	}
+	for _, id := range deleted {
+		delete(s.hashes, id)
+	}
+	return nil
+}
+
+// FileDocumentHashStore is a DocumentHashStore saving the hashes as JSON in a
+// local file.
+type FileDocumentHashStore struct {
+	Path string
+}
+
+var _ DocumentHashStore = &FileDocumentHashStore{}
+
+// NewFileDocumentHashStore creates a FileDocumentHashStore saving the hashes
+// in the file at path
+func NewFileDocumentHashStore(path string) *FileDocumentHashStore {
+	return &FileDocumentHashStore{
+		Path: path,
+	}
+}
+
+func (s *FileDocumentHashStore) Hashes() (map[string]string, error) {
+	data, err := ioutil.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read document hashes")
+	}
+	hashes := map[string]string{}
+	if err := json.Unmarshal(data, &hashes); err != nil {
+		return nil, errors.Wrap(err, "could not decode document hashes")
+	}
+	return hashes, nil
+}
+
+func (s *FileDocumentHashStore) Update(changed map[string]string, deleted []string) error {
+	hashes, err := s.Hashes()
+	if err != nil {
+		return err
+	}
+	for id, hash := range changed {
+		hashes[id] = hash
+	}
+	for _, id := range deleted {
+		delete(hashes, id)
+	}
+
+	data, err := json.Marshal(hashes)
+	if err != nil {
+		return errors.Wrap(err, "could not encode document hashes")
+	}
+	if err := writeFileAtomic(s.Path, data); err != nil {
+		return errors.Wrap(err, "could not write document hashes")
+	}
+	return nil
+}
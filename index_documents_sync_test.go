@@ -0,0 +1,140 @@
+package meilisearch
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndex_SyncDocuments(t *testing.T) {
+	tests := []struct {
+		name  string
+		UID   string
+		store DocumentHashStore
+	}{
+		{
+			name:  "TestIndexSyncDocumentsMemoryStore",
+			UID:   "TestIndexSyncDocumentsMemoryStore",
+			store: NewMemoryDocumentHashStore(),
+		},
+		{
+			name:  "TestIndexSyncDocumentsFileStore",
+			UID:   "TestIndexSyncDocumentsFileStore",
+			store: NewFileDocumentHashStore(filepath.Join(t.TempDir(), "hashes.json")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := defaultClient
+			i := c.Index(tt.UID)
+			t.Cleanup(cleanup(c))
+
+			firstSync := []docTestBooks{
+				{BookID: 123, Title: "Pride and Prejudice", Tag: "Romance", Year: 1813},
+				{BookID: 456, Title: "Le Petit Prince", Tag: "Tale", Year: 1943},
+				{BookID: 1, Title: "Alice In Wonderland", Tag: "Tale", Year: 1865},
+			}
+			gotResp, err := i.SyncDocuments(context.Background(), firstSync, "book_id", 2, tt.store)
+			require.NoError(t, err)
+			require.Equal(t, 3, gotResp.Added)
+			require.Equal(t, 0, gotResp.Updated)
+			require.Equal(t, 0, gotResp.Deleted)
+			require.Equal(t, 0, gotResp.Unchanged)
+			require.Len(t, gotResp.Tasks, 2)
+
+			secondSync := []map[string]interface{}{
+				{"book_id": 1, "title": "Alice In Wonderland", "tag": "Tale", "year": 1865},
+				{"year": 1813, "tag": "Romance", "title": "Pride and Prejudice", "book_id": 123},
+				{"book_id": 1344, "title": "The Hobbit", "tag": "Epic fantasy", "year": 1937},
+			}
+			gotResp, err = i.SyncDocuments(context.Background(), secondSync, "book_id", 2, tt.store)
+			require.NoError(t, err)
+			require.Equal(t, 1, gotResp.Added)
+			require.Equal(t, 0, gotResp.Updated)
+			require.Equal(t, 1, gotResp.Deleted)
+			require.Equal(t, 2, gotResp.Unchanged)
+			require.Len(t, gotResp.Tasks, 2)
+
+			secondSync[0]["tag"] = "Fantasy"
+			gotResp, err = i.SyncDocuments(context.Background(), secondSync, "book_id", 2, tt.store)
+			require.NoError(t, err)
+			require.Equal(t, 0, gotResp.Added)
+			require.Equal(t, 1, gotResp.Updated)
+			require.Equal(t, 0, gotResp.Deleted)
+			require.Equal(t, 2, gotResp.Unchanged)
+			require.Len(t, gotResp.Tasks, 1)
+
+			var documents []docTestBooks
+			err = i.GetDocuments(&DocumentsRequest{}, &documents)
+			require.NoError(t, err)
+			require.ElementsMatch(t, []docTestBooks{
+				{BookID: 123, Title: "Pride and Prejudice", Tag: "Romance", Year: 1813},
+				{BookID: 1, Title: "Alice In Wonderland", Tag: "Fantasy", Year: 1865},
+				{BookID: 1344, Title: "The Hobbit", Tag: "Epic fantasy", Year: 1937},
+			}, documents)
+		})
+	}
+}
+
+func TestIndex_SyncDocumentsInvalidPrimaryKey(t *testing.T) {
+	store := NewMemoryDocumentHashStore()
+	i := defaultClient.Index("TestIndexSyncDocumentsInvalidPrimaryKey")
+
+	_, err := i.SyncDocuments(context.Background(), []map[string]interface{}{
+		{"id": 1, "title": "Alice In Wonderland"},
+		{"title": "Pride and Prejudice"},
+	}, "id", 10, store)
+	require.Error(t, err)
+
+	_, err = i.SyncDocuments(context.Background(), []map[string]interface{}{
+		{"id": 1, "title": "Alice In Wonderland"},
+		{"id": 1, "title": "Pride and Prejudice"},
+	}, "id", 10, store)
+	require.Error(t, err)
+
+	hashes, err := store.Hashes()
+	require.NoError(t, err)
+	require.Empty(t, hashes)
+}
+
+func TestIndex_SyncDocumentsInvalidArguments(t *testing.T) {
+	store := NewMemoryDocumentHashStore()
+	i := defaultClient.Index("TestIndexSyncDocumentsInvalidArguments")
+	documents := []map[string]interface{}{
+		{"id": 1, "title": "Alice In Wonderland"},
+	}
+
+	_, err := i.SyncDocuments(context.Background(), documents, "id", 0, store)
+	require.EqualError(t, err, "invalid batch size 0, must be positive")
+
+	_, err = i.SyncDocuments(context.Background(), documents, "id", -1, store)
+	require.EqualError(t, err, "invalid batch size -1, must be positive")
+
+	_, err = i.SyncDocuments(context.Background(), documents[0], "id", 10, store)
+	require.EqualError(t, err, "could not sync documents of type map[string]interface {}: not a slice")
+
+	_, err = i.SyncDocuments(context.Background(), &documents[0], "id", 10, store)
+	require.Error(t, err)
+
+	hashes, err := store.Hashes()
+	require.NoError(t, err)
+	require.Empty(t, hashes)
+}
+
+func TestIndex_SyncDocumentsPointer(t *testing.T) {
+	c := defaultClient
+	t.Cleanup(cleanup(c))
+	i := c.Index("TestIndexSyncDocumentsPointer")
+	store := NewMemoryDocumentHashStore()
+
+	documents := []map[string]interface{}{
+		{"id": 1, "title": "Alice In Wonderland"},
+		{"id": 2, "title": "Pride and Prejudice"},
+	}
+	resp, err := i.SyncDocuments(context.Background(), &documents, "id", 1, store)
+	require.NoError(t, err)
+	require.Equal(t, 2, resp.Added)
+	require.Len(t, resp.Tasks, 2)
+}
@@ -5,10 +5,12 @@ import (
 	"bytes"
 	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"io"
 	"strconv"
 	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/stretchr/testify/require"
 )
@@ -825,6 +827,76 @@ func TestIndex_AddDocumentsNdjsonInBatches(t *testing.T) {
 	}
 }
 
+func TestIndex_AddDocumentsInBatchesError(t *testing.T) {
+	c := defaultClient
+	t.Cleanup(cleanup(c))
+
+	t.Run("TestIndexAddDocumentsInBatchesMarshalError", func(t *testing.T) {
+		i := c.Index("TestIndexAddDocumentsInBatchesMarshalError")
+		documents := []map[string]interface{}{
+			{"ID": "1", "Name": "Alice In Wonderland"},
+			{"ID": "2", "Name": "Pride and Prejudice"},
+			{"ID": "3", "Name": "Le Petit Prince", "Invalid": func() {}},
+		}
+
+		gotResp, err := i.AddDocumentsInBatches(documents, 2, "ID")
+		require.Error(t, err)
+		require.Nil(t, gotResp)
+
+		var batchErr *BatchError
+		require.True(t, errors.As(err, &batchErr))
+		require.Len(t, batchErr.Tasks, 1)
+		require.Equal(t, 1, batchErr.BatchIndex)
+		require.Equal(t, 2, batchErr.Start)
+		require.Equal(t, 3, batchErr.End)
+		require.Equal(t, int64(-1), batchErr.StartOffset)
+		require.Equal(t, ErrCodeMarshalRequest, batchErr.OriginError.(*Error).ErrCode)
+
+		testWaitForBatchTask(t, i, batchErr.Tasks)
+	})
+
+	t.Run("TestIndexAddDocumentsCsvFromReaderInBatchesReadError", func(t *testing.T) {
+		i := c.Index("TestIndexAddDocumentsCsvFromReaderInBatchesReadError")
+		documents := []byte("id,name\n1,Alice In Wonderland\n2,Pride and Prejudice\n3,\"Le Petit\" Prince\n")
+
+		gotResp, err := i.AddDocumentsCsvFromReaderInBatches(bytes.NewReader(documents), 2)
+		require.Error(t, err)
+		require.Nil(t, gotResp)
+
+		var batchErr *BatchError
+		require.True(t, errors.As(err, &batchErr))
+		require.Len(t, batchErr.Tasks, 1)
+		require.Equal(t, 1, batchErr.BatchIndex)
+		require.Equal(t, 2, batchErr.Start)
+		require.Equal(t, 3, batchErr.End)
+
+		testWaitForBatchTask(t, i, batchErr.Tasks)
+	})
+
+	t.Run("TestIndexAddDocumentsNdjsonFromReaderInBatchesReadError", func(t *testing.T) {
+		i := c.Index("TestIndexAddDocumentsNdjsonFromReaderInBatchesReadError")
+		documents := io.MultiReader(
+			bytes.NewReader(testNdjsonDocuments),
+			iotest.ErrReader(errors.New("connection reset")),
+		)
+
+		gotResp, err := i.AddDocumentsNdjsonFromReaderInBatches(documents, 2)
+		require.Error(t, err)
+		require.Nil(t, gotResp)
+
+		var batchErr *BatchError
+		require.True(t, errors.As(err, &batchErr))
+		require.Len(t, batchErr.Tasks, 2)
+		require.Equal(t, 2, batchErr.BatchIndex)
+		require.Equal(t, 4, batchErr.Start)
+		require.Equal(t, 6, batchErr.End)
+		require.Equal(t, int64(bytes.Index(testNdjsonDocuments, []byte(`{"id": 5`))), batchErr.StartOffset)
+		require.Equal(t, int64(len(testNdjsonDocuments)), batchErr.EndOffset)
+
+		testWaitForBatchTask(t, i, batchErr.Tasks)
+	})
+}
+
 func TestIndex_DeleteAllDocuments(t *testing.T) {
 	type args struct {
 		UID    string
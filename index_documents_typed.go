@@ -0,0 +1,26 @@
+package meilisearch
+
+// GetDocumentAs gets the document identified by identifier from index,
+// decoded as a T. Only fields are retrieved if any are given.
+// If the document does not exist, the returned error matches
+// ErrDocumentNotFound with errors.Is.
+func GetDocumentAs[T any](index *Index, identifier string, fields ...string) (*T, error) {
+	document := new(T)
+	if err := index.getDocument(identifier, fields, document); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+// GetDocumentsAs gets the documents of index selected by request, decoded as
+// Ts. Only request.AttributesToRetrieve are retrieved if any are given.
+func GetDocumentsAs[T any](index *Index, request *DocumentsRequest) ([]T, error) {
+	var documents []T
+	if err := index.GetDocuments(request, &documents); err != nil {
+		return nil, err
+	}
+	if documents == nil {
+		documents = []T{}
+	}
+	return documents, nil
+}
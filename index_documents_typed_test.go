@@ -0,0 +1,105 @@
+package meilisearch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDocumentAs(t *testing.T) {
+	c := defaultClient
+	i := c.Index("TestGetDocumentAs")
+	t.Cleanup(cleanup(c))
+
+	task, err := i.AddDocuments([]docTestBooks{
+		{BookID: 123, Title: "Pride and Prejudice", Tag: "Romance", Year: 1813},
+		{BookID: 456, Title: "Le Petit Prince", Tag: "Tale", Year: 1943},
+	}, "book_id")
+	require.NoError(t, err)
+	testWaitForTask(t, i, task)
+
+	tests := []struct {
+		name       string
+		identifier string
+		fields     []string
+		want       *docTestBooks
+		wantErr    error
+	}{
+		{
+			name:       "TestGetDocumentAsAllFields",
+			identifier: "123",
+			want:       &docTestBooks{BookID: 123, Title: "Pride and Prejudice", Tag: "Romance", Year: 1813},
+		},
+		{
+			name:       "TestGetDocumentAsFields",
+			identifier: "456",
+			fields:     []string{"book_id", "title"},
+			want:       &docTestBooks{BookID: 456, Title: "Le Petit Prince"},
+		},
+		{
+			name:       "TestGetDocumentAsWildcard",
+			identifier: "123",
+			fields:     []string{"title", "*"},
+			want:       &docTestBooks{BookID: 123, Title: "Pride and Prejudice", Tag: "Romance", Year: 1813},
+		},
+		{
+			name:       "TestGetDocumentAsNotFound",
+			identifier: "789",
+			wantErr:    ErrDocumentNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetDocumentAs[docTestBooks](i, tt.identifier, tt.fields...)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, got)
+
+				// The API error is still available
+				var meiliErr *Error
+				require.True(t, errors.As(err, &meiliErr))
+				require.Equal(t, 404, meiliErr.StatusCode)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("TestGetDocumentAsIndexNotFound", func(t *testing.T) {
+		_, err := GetDocumentAs[docTestBooks](c.Index("TestGetDocumentAsMissingIndex"), "123")
+		require.ErrorIs(t, err, ErrIndexNotFound)
+		require.False(t, errors.Is(err, ErrDocumentNotFound))
+	})
+}
+
+func TestGetDocumentsAs(t *testing.T) {
+	c := defaultClient
+	i := c.Index("TestGetDocumentsAs")
+	t.Cleanup(cleanup(c))
+
+	got, err := GetDocumentsAs[docTestBooks](i, &DocumentsRequest{})
+	require.ErrorIs(t, err, ErrIndexNotFound)
+	require.Nil(t, got)
+
+	task, err := i.AddDocuments([]docTestBooks{
+		{BookID: 123, Title: "Pride and Prejudice", Tag: "Romance", Year: 1813},
+		{BookID: 456, Title: "Le Petit Prince", Tag: "Tale", Year: 1943},
+		{BookID: 1, Title: "Alice In Wonderland", Tag: "Tale", Year: 1865},
+	}, "book_id")
+	require.NoError(t, err)
+	testWaitForTask(t, i, task)
+
+	got, err = GetDocumentsAs[docTestBooks](i, &DocumentsRequest{
+		Offset:               1,
+		Limit:                1,
+		AttributesToRetrieve: []string{"book_id", "year"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []docTestBooks{{BookID: 456, Year: 1943}}, got)
+
+	got, err = GetDocumentsAs[docTestBooks](i, &DocumentsRequest{Offset: 10})
+	require.NoError(t, err)
+	require.Equal(t, []docTestBooks{}, got)
+}
@@ -0,0 +1,331 @@
+package meilisearch
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrDocumentNotObject is the reason of a DocumentError for a document
+	// which is not a JSON object
+	ErrDocumentNotObject = errors.New("document is not an object")
+	// ErrMissingPrimaryKey is the reason of a DocumentError for a document
+	// without primary key value
+	ErrMissingPrimaryKey = errors.New("missing primary key")
+	// ErrInvalidPrimaryKey is the reason of a DocumentError for a document
+	// whose primary key value is not accepted by Meilisearch
+	ErrInvalidPrimaryKey = errors.New("invalid primary key")
+	// ErrDuplicatePrimaryKey is the reason of a DocumentError for a document
+	// whose primary key value is already used by a previous document
+	ErrDuplicatePrimaryKey = errors.New("duplicate primary key")
+)
+
+// maxPrimaryKeyLength is the maximum length in bytes of a document identifier
+const maxPrimaryKeyLength = 511
+
+// ValidateDocuments checks documents, a slice of values encoded as JSON
+// objects (or JSON bytes of an array of objects), like Meilisearch does when
+// adding them: every document must be an object holding a value for
+// primaryKey, an integer or a string of at most 511 bytes made of
+// alphanumeric characters, hyphens and underscores, and no two documents may
+// share it. The primary key is not checked if primaryKey is empty.
+// A *DocumentsValidationError locating every invalid document is returned.
+func ValidateDocuments(documents interface{}, primaryKey string) error {
+	decoded, err := decodeJSONDocuments(documents)
+	if err != nil {
+		return err
+	}
+	return validateDecodedDocuments(decoded, primaryKey)
+}
+
+// validateDocuments checks documents encoded with contentType before they are
+// sent if the client is configured to, using primaryKey or the primary key of
+// the index if known.
+func (i Index) validateDocuments(documents interface{}, contentType string, primaryKey ...string) error {
+	if !i.client.config.ValidateDocuments {
+		return nil
+	}
+	key := i.PrimaryKey
+	if primaryKey != nil {
+		key = primaryKey[0]
+	}
+
+	var (
+		decoded []interface{}
+		err     error
+	)
+	switch contentType {
+	case contentTypeCSV:
+		decoded, err = decodeCsvDocuments(documents.([]byte))
+	case contentTypeNDJSON:
+		decoded, err = decodeNdjsonDocuments(documents.([]byte))
+	default:
+		decoded, err = decodeJSONDocuments(documents)
+	}
+	if err != nil {
+		return err
+	}
+	return validateDecodedDocuments(decoded, key)
+}
+
+// inferPrimaryKey returns primaryKey, or the primary key InferPrimaryKey
+// infers from documents if the client is configured to and neither primaryKey
+// nor the primary key of the index is known.
+func (i Index) inferPrimaryKey(documents interface{}, primaryKey []string) ([]string, error) {
+	if !i.client.config.InferPrimaryKey || primaryKey != nil || i.PrimaryKey != "" {
+		return primaryKey, nil
+	}
+	key, err := InferPrimaryKey(documents)
+	if err != nil {
+		return nil, err
+	}
+	if key == "" {
+		return nil, nil
+	}
+	return []string{key}, nil
+}
+
+func validateDecodedDocuments(documents []interface{}, primaryKey string) error {
+	var (
+		errs []*DocumentError
+		seen = make(map[string]bool)
+	)
+	for j, document := range documents {
+		object, ok := document.(map[string]interface{})
+		if !ok {
+			errs = append(errs, &DocumentError{Record: j, Err: ErrDocumentNotObject})
+			continue
+		}
+		if primaryKey == "" {
+			continue
+		}
+
+		id, err := documentID(object, primaryKey)
+		if err != nil {
+			errs = append(errs, &DocumentError{Record: j, Err: err})
+			continue
+		}
+		if seen[id] {
+			errs = append(errs, &DocumentError{Record: j, Err: errors.Wrapf(ErrDuplicatePrimaryKey, "%q", id)})
+			continue
+		}
+		seen[id] = true
+	}
+
+	if errs != nil {
+		return &DocumentsValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// documentID returns the primary key value of document as a string
+func documentID(document map[string]interface{}, primaryKey string) (string, error) {
+	value, ok := document[primaryKey]
+	if !ok || value == nil {
+		return "", errors.Wrapf(ErrMissingPrimaryKey, "%q", primaryKey)
+	}
+
+	switch v := value.(type) {
+	case json.Number:
+		if _, err := v.Int64(); err != nil {
+			return "", errors.Wrapf(ErrInvalidPrimaryKey, "%s is not an integer", v)
+		}
+		return v.String(), nil
+	case string:
+		if v == "" {
+			return "", errors.Wrap(ErrInvalidPrimaryKey, "empty string")
+		}
+		if len(v) > maxPrimaryKeyLength {
+			return "", errors.Wrapf(ErrInvalidPrimaryKey, "longer than %d bytes", maxPrimaryKeyLength)
+		}
+		for _, c := range v {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+				return "", errors.Wrapf(ErrInvalidPrimaryKey, "%q holds %q, only alphanumeric characters, hyphens and underscores are allowed", v, c)
+			}
+		}
+		return v, nil
+	default:
+		return "", errors.Wrapf(ErrInvalidPrimaryKey, "%T is neither an integer nor a string", value)
+	}
+}
+
+// decodeJSONDocuments decodes documents as they would be encoded in JSON,
+// keeping numbers exact
+func decodeJSONDocuments(documents interface{}) ([]interface{}, error) {
+	data, ok := documents.([]byte)
+	if !ok {
+		var err error
+		if data, err = json.Marshal(documents); err != nil {
+			return nil, errors.Wrap(err, "could not encode documents")
+		}
+	}
+
+	var decoded interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&decoded); err != nil {
+		return nil, errors.Wrap(err, "could not decode documents")
+	}
+	if array, ok := decoded.([]interface{}); ok {
+		return array, nil
+	}
+	// A single object is accepted as a single document
+	return []interface{}{decoded}, nil
+}
+
+func decodeNdjsonDocuments(documents []byte) ([]interface{}, error) {
+	var decoded []interface{}
+	scanner := bufio.NewScanner(bytes.NewReader(documents))
+	scanner.Buffer(nil, len(documents)+1)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var document interface{}
+		d := json.NewDecoder(strings.NewReader(line))
+		d.UseNumber()
+		if err := d.Decode(&document); err != nil {
+			return nil, errors.Wrapf(err, "could not decode document %d", len(decoded))
+		}
+		decoded = append(decoded, document)
+	}
+	return decoded, scanner.Err()
+}
+
+// decodeCsvDocuments decodes CSV documents into objects of strings, or numbers
+// for the columns typed as numbers in the header
+func decodeCsvDocuments(documents []byte) ([]interface{}, error) {
+	r := csv.NewReader(bytes.NewReader(documents))
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read CSV header")
+	}
+	names := make([]string, len(header))
+	numbers := make([]bool, len(header))
+	for j, name := range header {
+		names[j] = name
+		if k := strings.LastIndexByte(name, ':'); k >= 0 {
+			names[j] = name[:k]
+			numbers[j] = CsvColumnType(name[k+1:]) == CsvNumber
+		}
+	}
+
+	var decoded []interface{}
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read CSV record %d", len(decoded))
+		}
+		document := make(map[string]interface{}, len(record))
+		for j, value := range record {
+			switch {
+			case value == "" && numbers[j]:
+				document[names[j]] = nil
+			case numbers[j]:
+				document[names[j]] = json.Number(value)
+			default:
+				document[names[j]] = value
+			}
+		}
+		decoded = append(decoded, document)
+	}
+	return decoded, nil
+}
+
+// InferPrimaryKey returns the primary key Meilisearch v0.25 infers from
+// documents when none is given for an index without primary key: the first
+// attribute of the first document whose name contains "id", ignoring case.
+// documents is a slice of values encoded as JSON objects, or JSON bytes of an
+// array of objects. Attributes are taken in the order they are encoded, that
+// is in field order for structs and sorted for maps.
+//
+// Documents are added without primary key unless one is given or the client is
+// configured with ClientConfig.InferPrimaryKey, otherwise InferPrimaryKey is
+// meant to fail early on documents the server would reject:
+//
+//	primaryKey, err := meilisearch.InferPrimaryKey(documents)
+//	...
+//	task, err := index.AddDocuments(documents, primaryKey)
+//
+// A *PrimaryKeyInferenceError is returned if no attribute contains "id". An
+// empty string is returned if there is no document.
+func InferPrimaryKey(documents interface{}) (string, error) {
+	attributes, err := firstDocumentAttributes(documents)
+	if err != nil {
+		return "", err
+	}
+	if attributes == nil {
+		return "", nil
+	}
+	for _, attribute := range attributes {
+		if strings.Contains(strings.ToLower(attribute), "id") {
+			return attribute, nil
+		}
+	}
+	return "", &PrimaryKeyInferenceError{Attributes: attributes}
+}
+
+// firstDocumentAttributes returns the attributes of the first document in the
+// order they are encoded, nil if there is no document
+func firstDocumentAttributes(documents interface{}) ([]string, error) {
+	data, ok := documents.([]byte)
+	if !ok {
+		v := reflect.ValueOf(documents)
+		if v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+			// Only encode the first document
+			if v.Len() == 0 {
+				return nil, nil
+			}
+			documents = v.Index(0).Interface()
+		}
+		var err error
+		if data, err = json.Marshal(documents); err != nil {
+			return nil, errors.Wrap(err, "could not encode documents")
+		}
+	}
+
+	d := json.NewDecoder(bytes.NewReader(data))
+	token, err := d.Token()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode documents")
+	}
+	if token == json.Delim('[') {
+		// The first element of an array is the first document
+		if token, err = d.Token(); err != nil {
+			return nil, errors.Wrap(err, "could not decode documents")
+		}
+		if token == json.Delim(']') {
+			return nil, nil
+		}
+	}
+	if token != json.Delim('{') {
+		return nil, &DocumentsValidationError{Errors: []*DocumentError{{Record: 0, Err: ErrDocumentNotObject}}}
+	}
+	attributes := []string{}
+	for d.More() {
+		token, err := d.Token()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decode documents")
+		}
+		var value json.RawMessage
+		if err := d.Decode(&value); err != nil {
+			return nil, errors.Wrap(err, "could not decode documents")
+		}
+		attributes = append(attributes, token.(string))
+	}
+	return attributes, nil
+}
@@ -0,0 +1,285 @@
+package meilisearch
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDocuments(t *testing.T) {
+	tests := []struct {
+		name       string
+		documents  interface{}
+		primaryKey string
+		wantErrs   []error
+	}{
+		{
+			name: "TestValidateDocumentsValid",
+			documents: []map[string]interface{}{
+				{"id": 1, "title": "Alice In Wonderland"},
+				{"id": "book-2_fr", "title": "Le Petit Prince"},
+			},
+			primaryKey: "id",
+		},
+		{
+			name:       "TestValidateDocumentsStructs",
+			documents:  []docTest{{ID: "1", Name: "Alice In Wonderland"}, {ID: "1", Name: "Le Petit Prince"}},
+			primaryKey: "id",
+			wantErrs:   []error{nil, ErrDuplicatePrimaryKey},
+		},
+		{
+			name:       "TestValidateDocumentsJSONBytes",
+			documents:  []byte(`[{"id": 1}, {"id": 1.5}, {"id": true}, {"id": null}, {"title": "Hamlet"}, "Hamlet"]`),
+			primaryKey: "id",
+			wantErrs:   []error{nil, ErrInvalidPrimaryKey, ErrInvalidPrimaryKey, ErrMissingPrimaryKey, ErrMissingPrimaryKey, ErrDocumentNotObject},
+		},
+		{
+			name: "TestValidateDocumentsInvalidStrings",
+			documents: []map[string]interface{}{
+				{"id": "a b"},
+				{"id": ""},
+				{"id": strings.Repeat("a", 512)},
+				{"id": "é"},
+			},
+			primaryKey: "id",
+			wantErrs:   []error{ErrInvalidPrimaryKey, ErrInvalidPrimaryKey, ErrInvalidPrimaryKey, ErrInvalidPrimaryKey},
+		},
+		{
+			name:      "TestValidateDocumentsNoPrimaryKey",
+			documents: []interface{}{map[string]interface{}{"title": "Hamlet"}, 42},
+			wantErrs:  []error{nil, ErrDocumentNotObject},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDocuments(tt.documents, tt.primaryKey)
+			if tt.wantErrs == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			var validationErr *DocumentsValidationError
+			require.True(t, errors.As(err, &validationErr))
+			var want []*DocumentError
+			for j, wantErr := range tt.wantErrs {
+				if wantErr != nil {
+					want = append(want, &DocumentError{Record: j, Err: wantErr})
+				}
+			}
+			require.Len(t, validationErr.Errors, len(want))
+			for j, got := range validationErr.Errors {
+				require.Equal(t, want[j].Record, got.Record)
+				require.ErrorIs(t, got, want[j].Err)
+			}
+		})
+	}
+}
+
+func TestIndex_AddDocumentsValidation(t *testing.T) {
+	c := NewClient(ClientConfig{
+		Host:              "http://localhost:7700",
+		APIKey:            masterKey,
+		ValidateDocuments: true,
+	})
+	t.Cleanup(cleanup(c))
+
+	t.Run("TestIndexAddDocumentsInBatchesValidation", func(t *testing.T) {
+		i := c.Index("TestIndexAddDocumentsInBatchesValidation")
+		documents := []docTest{
+			{ID: "1", Name: "Alice In Wonderland"},
+			{ID: "2", Name: "Pride and Prejudice"},
+			{ID: "3", Name: "Le Petit Prince"},
+			{ID: "3 bis", Name: "Le Petit Prince"},
+		}
+
+		gotResp, err := i.AddDocumentsInBatches(documents, 2, "id")
+		require.Error(t, err)
+		require.Nil(t, gotResp)
+
+		var batchErr *BatchError
+		require.True(t, errors.As(err, &batchErr))
+		require.Empty(t, batchErr.Tasks)
+		require.Equal(t, 1, batchErr.BatchIndex)
+		require.Equal(t, 2, batchErr.Start)
+
+		var validationErr *DocumentsValidationError
+		require.True(t, errors.As(err, &validationErr))
+		require.Len(t, validationErr.Errors, 1)
+		require.Equal(t, 1, validationErr.Errors[0].Record)
+		require.ErrorIs(t, validationErr.Errors[0], ErrInvalidPrimaryKey)
+
+		// Nothing was sent
+		_, err = c.GetIndex("TestIndexAddDocumentsInBatchesValidation")
+		require.ErrorIs(t, err, ErrIndexNotFound)
+	})
+
+	t.Run("TestIndexAddDocumentsCsvValidation", func(t *testing.T) {
+		i := c.Index("TestIndexAddDocumentsCsvValidation")
+		gotResp, err := i.AddDocumentsCsv([]byte("id:number,name\n1,Alice In Wonderland\n1,Pride and Prejudice\n"), "id")
+		require.Nil(t, gotResp)
+		var validationErr *DocumentsValidationError
+		require.True(t, errors.As(err, &validationErr))
+		require.Equal(t, 1, validationErr.Errors[0].Record)
+		require.ErrorIs(t, validationErr.Errors[0], ErrDuplicatePrimaryKey)
+	})
+
+	t.Run("TestIndexAddDocumentsNdjsonValidation", func(t *testing.T) {
+		i := c.Index("TestIndexAddDocumentsNdjsonValidation")
+		gotResp, err := i.AddDocumentsNdjson([]byte("{\"id\": 1}\n\n{\"name\": \"Hamlet\"}\n"), "id")
+		require.Nil(t, gotResp)
+		var validationErr *DocumentsValidationError
+		require.True(t, errors.As(err, &validationErr))
+		require.Equal(t, 1, validationErr.Errors[0].Record)
+		require.ErrorIs(t, validationErr.Errors[0], ErrMissingPrimaryKey)
+	})
+
+	t.Run("TestIndexUpdateDocumentsValid", func(t *testing.T) {
+		i := c.Index("TestIndexUpdateDocumentsValid")
+		task, err := i.UpdateDocuments([]docTest{{ID: "1", Name: "Alice In Wonderland"}}, "id")
+		require.NoError(t, err)
+		testWaitForTask(t, i, task)
+	})
+}
+
+func TestInferPrimaryKey(t *testing.T) {
+	type book struct {
+		Title    string `json:"title"`
+		AuthorID int    `json:"author_id"`
+		BookID   int    `json:"book_id"`
+	}
+	tests := []struct {
+		name      string
+		documents interface{}
+		want      string
+		wantErr   error
+	}{
+		{
+			name:      "TestInferPrimaryKeyID",
+			documents: []docTest{{ID: "1", Name: "Alice In Wonderland"}},
+			want:      "id",
+		},
+		{
+			name:      "TestInferPrimaryKeyContains",
+			documents: []map[string]interface{}{{"title": "Hamlet", "IdBook": 1}, {"isbn_id": 2}},
+			want:      "IdBook",
+		},
+		{
+			name:      "TestInferPrimaryKeyFirstField",
+			documents: []book{{Title: "Hamlet", AuthorID: 1, BookID: 2}},
+			want:      "author_id",
+		},
+		{
+			name:      "TestInferPrimaryKeyJSONBytes",
+			documents: []byte(`[{"title": "Hamlet", "kind": "play", "book_id": 1, "id": 2}]`),
+			want:      "book_id",
+		},
+		{
+			name:      "TestInferPrimaryKeyEmpty",
+			documents: []docTest{},
+			want:      "",
+		},
+		{
+			name:      "TestInferPrimaryKeyEmptyJSONBytes",
+			documents: []byte(`[]`),
+			want:      "",
+		},
+		{
+			name:      "TestInferPrimaryKeyNone",
+			documents: []byte(`[{"title": "Hamlet", "author": "Shakespeare"}]`),
+			wantErr:   &PrimaryKeyInferenceError{Attributes: []string{"title", "author"}},
+		},
+		{
+			name:      "TestInferPrimaryKeyNotObject",
+			documents: []interface{}{"Hamlet"},
+			wantErr:   &DocumentsValidationError{Errors: []*DocumentError{{Record: 0, Err: ErrDocumentNotObject}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := InferPrimaryKey(tt.documents)
+			if tt.wantErr != nil {
+				require.Equal(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+	require.EqualError(t, &PrimaryKeyInferenceError{Attributes: []string{"title", "author"}},
+		`could not infer primary key: no attribute of the first document (title, author) contains "id", pass the primary key explicitly`)
+}
+
+func TestIndex_AddDocumentsInferredPrimaryKey(t *testing.T) {
+	c := defaultClient
+	t.Cleanup(cleanup(c))
+	i := c.Index("TestIndexAddDocumentsInferredPrimaryKey")
+
+	documents := []map[string]interface{}{
+		{"book_id": 1, "title": "Alice In Wonderland"},
+		{"book_id": 2, "title": "Pride and Prejudice"},
+		{"book_id": 3, "title": "Le Petit Prince"},
+	}
+	primaryKey, err := InferPrimaryKey(documents)
+	require.NoError(t, err)
+	require.Equal(t, "book_id", primaryKey)
+	gotResp, err := i.AddDocumentsInBatches(documents, 2, primaryKey)
+	require.NoError(t, err)
+	testWaitForBatchTask(t, i, gotResp)
+
+	gotIndex, err := c.GetIndex(i.UID)
+	require.NoError(t, err)
+	require.Equal(t, "book_id", gotIndex.PrimaryKey)
+}
+
+func TestIndex_AddDocumentsInferPrimaryKey(t *testing.T) {
+	c := NewClient(ClientConfig{
+		Host:            "http://localhost:7700",
+		APIKey:          masterKey,
+		InferPrimaryKey: true,
+	})
+	t.Cleanup(cleanup(c))
+
+	t.Run("TestIndexAddDocumentsInBatchesInferPrimaryKey", func(t *testing.T) {
+		i := c.Index("TestIndexAddDocumentsInBatchesInferPrimaryKey")
+		documents := []map[string]interface{}{
+			{"book_id": 1, "title": "Alice In Wonderland"},
+			{"book_id": 2, "title": "Pride and Prejudice"},
+			{"book_id": 3, "title": "Le Petit Prince"},
+		}
+		gotResp, err := i.AddDocumentsInBatches(documents, 2)
+		require.NoError(t, err)
+		testWaitForBatchTask(t, i, gotResp)
+
+		gotIndex, err := c.GetIndex(i.UID)
+		require.NoError(t, err)
+		require.Equal(t, "book_id", gotIndex.PrimaryKey)
+	})
+
+	t.Run("TestIndexAddDocumentsInferPrimaryKeyError", func(t *testing.T) {
+		i := c.Index("TestIndexAddDocumentsInferPrimaryKeyError")
+		documents := []map[string]interface{}{
+			{"title": "Alice In Wonderland", "year": 1865},
+		}
+		gotResp, err := i.AddDocuments(documents)
+		require.Nil(t, gotResp)
+		var inferenceErr *PrimaryKeyInferenceError
+		require.True(t, errors.As(err, &inferenceErr))
+		require.Equal(t, []string{"title", "year"}, inferenceErr.Attributes)
+
+		// Nothing was sent
+		_, err = c.GetIndex(i.UID)
+		require.ErrorIs(t, err, ErrIndexNotFound)
+	})
+
+	t.Run("TestIndexAddDocumentsGivenPrimaryKey", func(t *testing.T) {
+		i := c.Index("TestIndexAddDocumentsGivenPrimaryKey")
+		documents := []map[string]interface{}{
+			{"title": "Alice In Wonderland", "isbn": "9780141439761"},
+		}
+		task, err := i.AddDocuments(documents, "isbn")
+		require.NoError(t, err)
+		testWaitForTask(t, i, task)
+	})
+}
@@ -0,0 +1,225 @@
+package meilisearch
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// IndexArchiveFormatVersion is the version of the archive format written by
+// Index.Export
+const IndexArchiveFormatVersion = 1
+
+// IndexArchiveHeader is the first line of an index archive written by
+// Index.Export. The following lines are the documents of the index in NDJSON.
+type IndexArchiveHeader struct {
+	FormatVersion     int       `json:"formatVersion"`
+	UID               string    `json:"uid"`
+	PrimaryKey        string    `json:"primaryKey,omitempty"`
+	CreatedAt         time.Time `json:"createdAt"`
+	UpdatedAt         time.Time `json:"updatedAt"`
+	ExportedAt        time.Time `json:"exportedAt"`
+	NumberOfDocuments int64     `json:"numberOfDocuments"`
+	Settings          Settings  `json:"settings"`
+}
+
+// ExportOptions configure Index.Export
+type ExportOptions struct {
+	// Gzip compresses the archive
+	Gzip bool
+
+	// PageSize is the number of documents fetched at a time,
+	// DefaultDocumentsPageSize if zero
+	PageSize int64
+}
+
+// ImportOptions configure Client.Import
+type ImportOptions struct {
+	// BatchSize is the number of documents sent at a time,
+	// DefaultDocumentsPageSize if zero
+	BatchSize int
+}
+
+// Export writes an archive of the index to w: an IndexArchiveHeader holding
+// the metadata, primary key and settings of the index on the first line,
+// followed by all its documents in NDJSON. The archive is compressed with
+// gzip if options.Gzip is set.
+// Documents added or deleted during the export may be skipped or written
+// twice, stop writes to the index for a consistent snapshot.
+func (i Index) Export(ctx context.Context, w io.Writer, options ...ExportOptions) error {
+	var opts ExportOptions
+	if options != nil {
+		opts = options[0]
+	}
+
+	info, err := i.FetchInfo()
+	if err != nil {
+		return err
+	}
+	settings, err := i.GetSettings()
+	if err != nil {
+		return err
+	}
+	stats, err := i.GetStats()
+	if err != nil {
+		return err
+	}
+	header := &IndexArchiveHeader{
+		FormatVersion:     IndexArchiveFormatVersion,
+		UID:               info.UID,
+		PrimaryKey:        info.PrimaryKey,
+		CreatedAt:         info.CreatedAt,
+		UpdatedAt:         info.UpdatedAt,
+		ExportedAt:        time.Now().UTC(),
+		NumberOfDocuments: stats.NumberOfDocuments,
+		Settings:          *settings,
+	}
+
+	var gz *gzip.Writer
+	if opts.Gzip {
+		gz = gzip.NewWriter(w)
+		w = gz
+	}
+	bw := bufio.NewWriter(w)
+
+	data, err := json.Marshal(header)
+	if err != nil {
+		return errors.Wrap(err, "could not encode archive header")
+	}
+	if _, err := bw.Write(append(data, '\n')); err != nil {
+		return errors.Wrap(err, "could not write archive")
+	}
+
+	line := new(bytes.Buffer)
+	it := i.Documents(ctx, &DocumentsIteratorOptions{PageSize: opts.PageSize, Prefetch: true})
+	for it.Next() {
+		line.Reset()
+		if err := json.Compact(line, it.Raw()); err != nil {
+			return errors.Wrap(err, "could not encode document")
+		}
+		line.WriteByte('\n')
+		if _, err := bw.Write(line.Bytes()); err != nil {
+			return errors.Wrap(err, "could not write archive")
+		}
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+
+	if err := bw.Flush(); err != nil {
+		return errors.Wrap(err, "could not write archive")
+	}
+	if gz != nil {
+		if err := gz.Close(); err != nil {
+			return errors.Wrap(err, "could not write archive")
+		}
+	}
+	return nil
+}
+
+// Import recreates an index exported by Index.Export from the archive read
+// from r, compressed with gzip or not, as the index targetUID: the index is
+// created with the primary key of the archive, the settings are applied and
+// the documents are added options.BatchSize at a time. Import fails if
+// targetUID already exists, and deletes targetUID if a later step fails. It
+// returns once every task succeeded.
+func (c *Client) Import(ctx context.Context, r io.Reader, targetUID string, options ...ImportOptions) (*Index, error) {
+	var opts ImportOptions
+	if options != nil {
+		opts = options[0]
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultDocumentsPageSize
+	}
+
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(2)
+	if err != nil && err != io.EOF {
+		return nil, errors.Wrap(err, "could not read archive")
+	}
+	if bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read archive")
+		}
+		defer gz.Close()
+		br = bufio.NewReader(gz)
+	}
+
+	line, err := br.ReadBytes('\n')
+	if err != nil && err != io.EOF {
+		return nil, errors.Wrap(err, "could not read archive")
+	}
+	header := &IndexArchiveHeader{}
+	if err := json.Unmarshal(line, header); err != nil {
+		return nil, errors.Wrap(err, "could not decode archive header")
+	}
+	if header.FormatVersion != IndexArchiveFormatVersion {
+		return nil, errors.Errorf("unsupported archive format version %d", header.FormatVersion)
+	}
+
+	index, err := c.createIndexWithSettings(ctx, targetUID, header.PrimaryKey, &header.Settings)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not import")
+	}
+
+	tasks, err := index.AddDocumentsNdjsonFromReaderInBatches(br, opts.BatchSize)
+	if err != nil {
+		return nil, c.discardIndex(targetUID, err)
+	}
+	for j := range tasks {
+		if _, err := c.waitForTaskSucceeded(ctx, &tasks[j]); err != nil {
+			return nil, c.discardIndex(targetUID, errors.Wrapf(err, "could not add documents to index %q", targetUID))
+		}
+	}
+	return index, nil
+}
+
+// createIndexWithSettings creates the index uid with primaryKey and applies
+// settings before any document is added so that documents are only indexed
+// once. It fails if uid already exists.
+func (c *Client) createIndexWithSettings(ctx context.Context, uid string, primaryKey string, settings *Settings) (*Index, error) {
+	if _, err := c.GetIndex(uid); err == nil {
+		return nil, errors.Errorf("index %q already exists", uid)
+	} else if !errors.Is(err, ErrIndexNotFound) {
+		return nil, err
+	}
+	task, err := c.CreateIndex(&IndexConfig{Uid: uid, PrimaryKey: primaryKey})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := c.waitForTaskSucceeded(ctx, task); err != nil {
+		return nil, errors.Wrapf(err, "could not create index %q", uid)
+	}
+	index := c.Index(uid)
+	index.PrimaryKey = primaryKey
+
+	task, err = index.UpdateSettings(settings)
+	if err != nil {
+		return nil, c.discardIndex(uid, err)
+	}
+	if _, err := c.waitForTaskSucceeded(ctx, task); err != nil {
+		return nil, c.discardIndex(uid, errors.Wrapf(err, "could not update settings of index %q", uid))
+	}
+	return index, nil
+}
+
+// discardIndex deletes the index uid created by an operation which failed with
+// err, and returns err, mentioning the deletion failure if any. The deletion
+// is waited for even if the context of the operation is done.
+func (c *Client) discardIndex(uid string, err error) error {
+	task, deleteErr := c.DeleteIndex(uid)
+	if deleteErr == nil {
+		_, deleteErr = c.waitForTaskSucceeded(context.Background(), task)
+	}
+	if deleteErr != nil {
+		return errors.Wrapf(err, "index %q could not be deleted (%v)", uid, deleteErr)
+	}
+	return err
+}
@@ -0,0 +1,108 @@
+package meilisearch
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndex_Export(t *testing.T) {
+	c := defaultClient
+	t.Cleanup(cleanup(c))
+
+	i := c.Index("TestIndexExport")
+	documents := testAddIteratedDocuments(t, i)
+	task, err := i.UpdateSettings(&Settings{
+		FilterableAttributes: []string{"tag"},
+		SortableAttributes:   []string{"year"},
+		StopWords:            []string{"the"},
+	})
+	require.NoError(t, err)
+	testWaitForTask(t, i, task)
+	wantSettings, err := i.GetSettings()
+	require.NoError(t, err)
+
+	for _, gzip := range []bool{false, true} {
+		name := "TestIndexExportPlain"
+		if gzip {
+			name = "TestIndexExportGzip"
+		}
+		t.Run(name, func(t *testing.T) {
+			archive := new(bytes.Buffer)
+			err := i.Export(context.Background(), archive, ExportOptions{Gzip: gzip, PageSize: 2})
+			require.NoError(t, err)
+
+			if !gzip {
+				header := &IndexArchiveHeader{}
+				firstLine, err := bufio.NewReader(bytes.NewReader(archive.Bytes())).ReadBytes('\n')
+				require.NoError(t, err)
+				require.NoError(t, json.Unmarshal(firstLine, header))
+				require.Equal(t, IndexArchiveFormatVersion, header.FormatVersion)
+				require.Equal(t, "TestIndexExport", header.UID)
+				require.Equal(t, "book_id", header.PrimaryKey)
+				require.Equal(t, int64(len(documents)), header.NumberOfDocuments)
+				require.Equal(t, len(documents)+1, strings.Count(archive.String(), "\n"))
+			}
+
+			index, err := c.Import(context.Background(), archive, name, ImportOptions{BatchSize: 2})
+			require.NoError(t, err)
+			require.Equal(t, name, index.UID)
+
+			gotIndex, err := c.GetIndex(name)
+			require.NoError(t, err)
+			require.Equal(t, "book_id", gotIndex.PrimaryKey)
+
+			gotSettings, err := index.GetSettings()
+			require.NoError(t, err)
+			require.Equal(t, wantSettings, gotSettings)
+
+			gotDocuments, err := GetDocumentsAs[docTestBooks](index, &DocumentsRequest{})
+			require.NoError(t, err)
+			require.Equal(t, documents, gotDocuments)
+		})
+	}
+
+	t.Run("TestIndexImportExistingIndex", func(t *testing.T) {
+		archive := new(bytes.Buffer)
+		require.NoError(t, i.Export(context.Background(), archive))
+		_, err := c.Import(context.Background(), archive, "TestIndexExport")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `index "TestIndexExport" already exists`)
+	})
+
+	t.Run("TestIndexImportUnsupportedVersion", func(t *testing.T) {
+		archive := strings.NewReader(`{"formatVersion": 42, "uid": "books"}` + "\n")
+		_, err := c.Import(context.Background(), archive, "TestIndexImportUnsupportedVersion")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unsupported archive format version 42")
+	})
+	t.Run("TestIndexImportLongLine", func(t *testing.T) {
+		// Longer than the default 64KiB limit of bufio.Scanner
+		title := strings.Repeat("a", 100*1024)
+		archive := `{"formatVersion": 1, "uid": "books", "primaryKey": "book_id"}` + "\n" +
+			`{"book_id": 1, "title": "` + title + `"}` + "\n"
+		index, err := c.Import(context.Background(), strings.NewReader(archive), "TestIndexImportLongLine")
+		require.NoError(t, err)
+
+		got, err := GetDocumentAs[docTestBooks](index, "1")
+		require.NoError(t, err)
+		require.Equal(t, title, got.Title)
+	})
+
+	t.Run("TestIndexImportInvalidDocument", func(t *testing.T) {
+		archive := `{"formatVersion": 1, "uid": "books", "primaryKey": "book_id"}` + "\n" +
+			`{"title": "Hamlet"}` + "\n"
+		_, err := c.Import(context.Background(), strings.NewReader(archive), "TestIndexImportInvalidDocument")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `could not add documents to index "TestIndexImportInvalidDocument"`)
+
+		// The index created by Import is deleted
+		_, err = c.GetIndex("TestIndexImportInvalidDocument")
+		require.ErrorIs(t, err, ErrIndexNotFound)
+	})
+}
@@ -0,0 +1,152 @@
+package meilisearch
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ReindexSource adds the documents of a reindex to shadow and returns the
+// tasks it enqueued, for instance with shadow.AddDocumentsInBatches.
+type ReindexSource func(ctx context.Context, shadow *Index) ([]Task, error)
+
+// ReindexOptions configure Index.Reindex
+type ReindexOptions struct {
+	// ShadowUID is the uid of the shadow index, the uid of the live index
+	// followed by the current UTC time (e.g. "products_20261017093000") if
+	// empty
+	ShadowUID string
+
+	// ExpectedDocuments is the number of documents the shadow index must
+	// hold once ingested, not checked if zero
+	ExpectedDocuments int64
+
+	// Validate is called with the stats of the live and the shadow indexes
+	// once the documents are ingested, the reindex is rolled back if it
+	// returns an error
+	Validate func(live, shadow *StatsIndex) error
+
+	// KeepPrevious keeps the previous documents of the live index under
+	// ShadowUID after the swap instead of deleting them
+	KeepPrevious bool
+}
+
+// ReindexResult describes a reindex done by Index.Reindex
+type ReindexResult struct {
+	// ShadowUID is the uid of the shadow index
+	ShadowUID string
+
+	// Swapped reports whether the shadow index was swapped with the live
+	// index
+	Swapped bool
+
+	// Stats are the stats of the shadow index before the swap
+	Stats StatsIndex
+}
+
+// Reindex rebuilds the index without exposing partially indexed documents:
+// a shadow index is created with the primary key and the settings of the
+// index, source adds the documents to it, and once every task succeeded and
+// the document counts are validated the shadow index is swapped with the
+// index and the previous documents are deleted. options may be nil.
+// By default the reindex fails if the shadow index is empty while the index
+// is not, see ReindexOptions to change the validation.
+// If any step before the swap fails the shadow index is deleted and the index
+// is left untouched. The index must exist.
+// Swapping requires Meilisearch v0.30 or later. With older servers the result
+// is returned along with an error matching ErrUnsupportedByServer, the index is
+// left untouched and the new documents are served by the shadow index, which
+// an alias can then point to with Client.SetAlias.
+func (i Index) Reindex(ctx context.Context, source ReindexSource, options *ReindexOptions) (*ReindexResult, error) {
+	var opts ReindexOptions
+	if options != nil {
+		opts = *options
+	}
+	if opts.ShadowUID == "" {
+		opts.ShadowUID = i.UID + "_" + time.Now().UTC().Format("20060102150405")
+	}
+
+	info, err := i.FetchInfo()
+	if err != nil {
+		return nil, err
+	}
+	settings, err := i.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	shadow, err := i.client.createIndexWithSettings(ctx, opts.ShadowUID, info.PrimaryKey, settings)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not create shadow index of %q", i.UID)
+	}
+
+	stats, err := i.fillShadowIndex(ctx, shadow, source, &opts)
+	if err != nil {
+		return nil, i.rollbackReindex(shadow, err)
+	}
+	result := &ReindexResult{ShadowUID: shadow.UID, Stats: *stats}
+
+	task, err := i.client.SwapIndexes([]SwapIndexesParams{{Indexes: []string{i.UID, shadow.UID}}})
+	if errors.Is(err, ErrUnsupportedByServer) {
+		return result, errors.Wrapf(err, "could not swap %q and %q, the new documents are served by %q", i.UID, shadow.UID, shadow.UID)
+	}
+	if err != nil {
+		return nil, i.rollbackReindex(shadow, err)
+	}
+	if _, err := i.client.waitForTaskSucceeded(ctx, task); err != nil {
+		return nil, i.rollbackReindex(shadow, errors.Wrapf(err, "could not swap %q and %q", i.UID, shadow.UID))
+	}
+	result.Swapped = true
+
+	if !opts.KeepPrevious {
+		// The previous documents of the index are now in the shadow index
+		task, err := i.client.DeleteIndex(shadow.UID)
+		if err == nil {
+			_, err = i.client.waitForTaskSucceeded(ctx, task)
+		}
+		if err != nil {
+			return result, errors.Wrapf(err, "could not delete previous index %q", shadow.UID)
+		}
+	}
+	return result, nil
+}
+
+// fillShadowIndex adds the documents of source to shadow, waits for the tasks
+// and validates the document counts.
+func (i Index) fillShadowIndex(ctx context.Context, shadow *Index, source ReindexSource, opts *ReindexOptions) (*StatsIndex, error) {
+	tasks, err := source(ctx, shadow)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not add documents to shadow index %q", shadow.UID)
+	}
+	for j := range tasks {
+		if _, err := i.client.waitForTaskSucceeded(ctx, &tasks[j]); err != nil {
+			return nil, errors.Wrapf(err, "could not add documents to shadow index %q", shadow.UID)
+		}
+	}
+
+	liveStats, err := i.GetStats()
+	if err != nil {
+		return nil, err
+	}
+	shadowStats, err := shadow.GetStats()
+	if err != nil {
+		return nil, err
+	}
+	if opts.ExpectedDocuments != 0 && shadowStats.NumberOfDocuments != opts.ExpectedDocuments {
+		return nil, errors.Errorf("shadow index %q holds %d documents instead of %d", shadow.UID, shadowStats.NumberOfDocuments, opts.ExpectedDocuments)
+	}
+	if opts.Validate != nil {
+		if err := opts.Validate(liveStats, shadowStats); err != nil {
+			return nil, errors.Wrapf(err, "shadow index %q is invalid", shadow.UID)
+		}
+	} else if shadowStats.NumberOfDocuments == 0 && liveStats.NumberOfDocuments != 0 {
+		return nil, errors.Errorf("shadow index %q is empty while %q holds %d documents", shadow.UID, i.UID, liveStats.NumberOfDocuments)
+	}
+	return shadowStats, nil
+}
+
+// rollbackReindex deletes shadow after the reindex failed with err. The
+// deletion is not bound to the context of the reindex, which may be done.
+func (i Index) rollbackReindex(shadow *Index, err error) error {
+	return errors.Wrapf(i.client.discardIndex(shadow.UID, err), "reindex of %q failed", i.UID)
+}
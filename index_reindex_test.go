@@ -0,0 +1,133 @@
+package meilisearch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndex_Reindex(t *testing.T) {
+	c := defaultClient
+	t.Cleanup(cleanup(c))
+
+	newDocuments := []docTestBooks{
+		{BookID: 10, Title: "Dune", Tag: "Science Fiction", Year: 1965},
+		{BookID: 11, Title: "Foundation", Tag: "Science Fiction", Year: 1951},
+	}
+	addNewDocuments := func(ctx context.Context, shadow *Index) ([]Task, error) {
+		return shadow.AddDocumentsInBatches(newDocuments, 1)
+	}
+	addNothing := func(ctx context.Context, shadow *Index) ([]Task, error) {
+		return nil, nil
+	}
+
+	tests := []struct {
+		name      string
+		source    ReindexSource
+		options   *ReindexOptions
+		want      []docTestBooks
+		wantErr   string
+		wantKept  bool
+		wantStats int64
+	}{
+		{
+			name:      "TestIndexReindex",
+			source:    addNewDocuments,
+			options:   &ReindexOptions{ExpectedDocuments: 2},
+			want:      newDocuments,
+			wantStats: 2,
+		},
+		{
+			name:      "TestIndexReindexKeepPrevious",
+			source:    addNewDocuments,
+			options:   &ReindexOptions{ShadowUID: "TestIndexReindexKeepPrevious_previous", KeepPrevious: true},
+			want:      newDocuments,
+			wantKept:  true,
+			wantStats: 2,
+		},
+		{
+			name:    "TestIndexReindexUnexpectedCount",
+			source:  addNewDocuments,
+			options: &ReindexOptions{ShadowUID: "TestIndexReindexUnexpectedCount_shadow", ExpectedDocuments: 3},
+			wantErr: `shadow index "TestIndexReindexUnexpectedCount_shadow" holds 2 documents instead of 3`,
+		},
+		{
+			name:    "TestIndexReindexEmpty",
+			source:  addNothing,
+			options: &ReindexOptions{ShadowUID: "TestIndexReindexEmpty_shadow"},
+			wantErr: `shadow index "TestIndexReindexEmpty_shadow" is empty while "TestIndexReindexEmpty" holds 5 documents`,
+		},
+		{
+			name:   "TestIndexReindexValidate",
+			source: addNewDocuments,
+			options: &ReindexOptions{
+				ShadowUID: "TestIndexReindexValidate_shadow",
+				Validate: func(live, shadow *StatsIndex) error {
+					if shadow.NumberOfDocuments < live.NumberOfDocuments {
+						return errors.New("documents were lost")
+					}
+					return nil
+				},
+			},
+			wantErr: "documents were lost",
+		},
+		{
+			name: "TestIndexReindexSourceError",
+			source: func(ctx context.Context, shadow *Index) ([]Task, error) {
+				return nil, errors.New("source is unavailable")
+			},
+			options: &ReindexOptions{ShadowUID: "TestIndexReindexSourceError_shadow"},
+			wantErr: "source is unavailable",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := c.Index(tt.name)
+			previous := testAddIteratedDocuments(t, i)
+			task, err := i.UpdateSettings(&Settings{FilterableAttributes: []string{"tag"}})
+			require.NoError(t, err)
+			testWaitForTask(t, i, task)
+
+			result, err := i.Reindex(context.Background(), tt.source, tt.options)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr)
+				require.Contains(t, err.Error(), `reindex of "`+tt.name+`" failed`)
+
+				// The index is untouched and the shadow index is deleted
+				gotDocuments, err := GetDocumentsAs[docTestBooks](i, &DocumentsRequest{})
+				require.NoError(t, err)
+				require.Equal(t, previous, gotDocuments)
+				_, err = c.GetIndex(tt.options.ShadowUID)
+				require.ErrorIs(t, err, ErrIndexNotFound)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, result.Swapped)
+			require.Equal(t, tt.wantStats, result.Stats.NumberOfDocuments)
+
+			gotDocuments, err := GetDocumentsAs[docTestBooks](i, &DocumentsRequest{})
+			require.NoError(t, err)
+			require.Equal(t, tt.want, gotDocuments)
+			gotSettings, err := i.GetSettings()
+			require.NoError(t, err)
+			require.Equal(t, []string{"tag"}, gotSettings.FilterableAttributes)
+
+			if tt.wantKept {
+				kept, err := GetDocumentsAs[docTestBooks](c.Index(result.ShadowUID), &DocumentsRequest{})
+				require.NoError(t, err)
+				require.Equal(t, previous, kept)
+			} else {
+				_, err = c.GetIndex(result.ShadowUID)
+				require.ErrorIs(t, err, ErrIndexNotFound)
+			}
+		})
+	}
+
+	t.Run("TestIndexReindexMissingIndex", func(t *testing.T) {
+		_, err := c.Index("TestIndexReindexMissingIndex").Reindex(context.Background(), addNewDocuments, nil)
+		require.ErrorIs(t, err, ErrIndexNotFound)
+	})
+}
@@ -0,0 +1,141 @@
+package meilisearch
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// IndexSchema is the primary key and the settings of an index, derived from
+// the `meilisearch` struct tags of a document type by IndexSchemaOf.
+type IndexSchema struct {
+	PrimaryKey string
+	Settings   Settings
+}
+
+// IndexSchemaOf derives the primary key and the settings of an index from the
+// `meilisearch` struct tags of the document type of v, a struct or a pointer to
+// a struct. A tag holds the field name followed by options:
+//
+//	ID    string   `meilisearch:"id,primary"`
+//	Genre string   `meilisearch:"genre,filterable,sortable,searchable"`
+//	Title string   `meilisearch:",searchable,displayed"`
+//	Notes string   `meilisearch:"-"`
+//
+// The field name defaults to the name encoding/json uses. The options are
+// primary, searchable, filterable, sortable, displayed and distinct. Fields of
+// nested structs are named with dots (e.g. "author.name").
+// SearchableAttributes and DisplayedAttributes are only set if a field has the
+// option, in the order of the fields, otherwise all fields stay searchable
+// and displayed.
+func IndexSchemaOf(v interface{}) (*IndexSchema, error) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, errors.Errorf("could not derive index schema of %T: not a struct", v)
+	}
+
+	schema := &IndexSchema{}
+	if err := addIndexSchemaFields(schema, t, "", map[reflect.Type]bool{}); err != nil {
+		return nil, errors.Wrapf(err, "could not derive index schema of %s", t)
+	}
+	return schema, nil
+}
+
+// IndexSchemaFor derives the primary key and the settings of an index from the
+// `meilisearch` struct tags of the document type T like IndexSchemaOf.
+func IndexSchemaFor[T any]() (*IndexSchema, error) {
+	var document T
+	return IndexSchemaOf(&document)
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func addIndexSchemaFields(schema *IndexSchema, t reflect.Type, prefix string, parents map[reflect.Type]bool) error {
+	parents[t] = true
+	defer delete(parents, t)
+
+	for j := 0; j < t.NumField(); j++ {
+		field := t.Field(j)
+		tag, hasTag := field.Tag.Lookup("meilisearch")
+		jsonName := strings.Split(field.Tag.Get("json"), ",")[0]
+		if tag == "-" || (!hasTag && jsonName == "-") {
+			continue
+		}
+		options := strings.Split(tag, ",")
+		name := options[0]
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Ptr || fieldType.Kind() == reflect.Slice || fieldType.Kind() == reflect.Array {
+			fieldType = fieldType.Elem()
+		}
+		isStruct := fieldType.Kind() == reflect.Struct && fieldType != timeType
+
+		// Fields of untagged embedded structs are promoted
+		if field.Anonymous && name == "" && jsonName == "" && isStruct {
+			if err := addIndexSchemaFields(schema, fieldType, prefix, parents); err != nil {
+				return err
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = jsonName
+		}
+		if name == "" {
+			name = field.Name
+		}
+		name = prefix + name
+
+		for _, option := range options[1:] {
+			switch option {
+			case "primary":
+				if schema.PrimaryKey != "" {
+					return errors.Errorf("both %q and %q are tagged primary", schema.PrimaryKey, name)
+				}
+				schema.PrimaryKey = name
+			case "searchable":
+				schema.Settings.SearchableAttributes = append(schema.Settings.SearchableAttributes, name)
+			case "filterable":
+				schema.Settings.FilterableAttributes = append(schema.Settings.FilterableAttributes, name)
+			case "sortable":
+				schema.Settings.SortableAttributes = append(schema.Settings.SortableAttributes, name)
+			case "displayed":
+				schema.Settings.DisplayedAttributes = append(schema.Settings.DisplayedAttributes, name)
+			case "distinct":
+				if schema.Settings.DistinctAttribute != nil {
+					return errors.Errorf("both %q and %q are tagged distinct", *schema.Settings.DistinctAttribute, name)
+				}
+				distinct := name
+				schema.Settings.DistinctAttribute = &distinct
+			default:
+				return errors.Errorf("unknown option %q in tag of %q", option, name)
+			}
+		}
+
+		if isStruct && !parents[fieldType] {
+			if err := addIndexSchemaFields(schema, fieldType, name+".", parents); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// EnsureIndexFor makes sure the index uid exists with the primary key and the
+// settings derived from the document type T by IndexSchemaFor, like
+// Client.EnsureIndex.
+func EnsureIndexFor[T any](ctx context.Context, client *Client, uid string) (*Index, error) {
+	schema, err := IndexSchemaFor[T]()
+	if err != nil {
+		return nil, err
+	}
+	return client.EnsureIndex(ctx, uid, schema.PrimaryKey, &schema.Settings)
+}
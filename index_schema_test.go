@@ -0,0 +1,129 @@
+package meilisearch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type schemaAuthorTest struct {
+	Name    string `json:"name" meilisearch:",filterable"`
+	Country string `json:"country"`
+}
+
+type schemaBookTest struct {
+	ID          string            `json:"id" meilisearch:"id,primary"`
+	Title       string            `json:"title" meilisearch:",searchable,displayed"`
+	Genre       string            `meilisearch:"genre,filterable,sortable,searchable"`
+	Author      *schemaAuthorTest `json:"author" meilisearch:",displayed"`
+	PublishedAt time.Time         `json:"published_at" meilisearch:",sortable"`
+	ISBN        string            `json:"isbn" meilisearch:",distinct"`
+	Internal    string            `json:"-"`
+	Notes       string            `json:"notes" meilisearch:"-"`
+	schemaEmbeddedTest
+}
+
+type schemaEmbeddedTest struct {
+	Rating float64 `json:"rating" meilisearch:",sortable"`
+}
+
+func TestIndexSchemaOf(t *testing.T) {
+	isbn := "isbn"
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    *IndexSchema
+		wantErr string
+	}{
+		{
+			name:  "TestIndexSchemaBasic",
+			value: schemaBookTest{},
+			want: &IndexSchema{
+				PrimaryKey: "id",
+				Settings: Settings{
+					SearchableAttributes: []string{"title", "genre"},
+					DisplayedAttributes:  []string{"title", "author"},
+					FilterableAttributes: []string{"genre", "author.name"},
+					SortableAttributes:   []string{"genre", "published_at", "rating"},
+					DistinctAttribute:    &isbn,
+				},
+			},
+		},
+		{
+			name: "TestIndexSchemaNoTag",
+			value: &struct {
+				ID string `json:"id"`
+			}{},
+			want: &IndexSchema{},
+		},
+		{
+			name: "TestIndexSchemaTwoPrimaryKeys",
+			value: struct {
+				ID  string `meilisearch:"id,primary"`
+				UID string `meilisearch:"uid,primary"`
+			}{},
+			wantErr: `both "id" and "uid" are tagged primary`,
+		},
+		{
+			name: "TestIndexSchemaUnknownOption",
+			value: struct {
+				ID string `meilisearch:"id,primay"`
+			}{},
+			wantErr: `unknown option "primay" in tag of "id"`,
+		},
+		{
+			name:    "TestIndexSchemaNotStruct",
+			value:   []string{},
+			wantErr: "not a struct",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IndexSchemaOf(tt.value)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEnsureIndexFor(t *testing.T) {
+	c := defaultClient
+	t.Cleanup(cleanup(c))
+
+	t.Run("TestEnsureIndexForCreate", func(t *testing.T) {
+		index, err := EnsureIndexFor[schemaBookTest](context.Background(), c, "TestEnsureIndexForCreate")
+		require.NoError(t, err)
+		require.Equal(t, "id", index.PrimaryKey)
+
+		gotIndex, err := c.GetIndex("TestEnsureIndexForCreate")
+		require.NoError(t, err)
+		require.Equal(t, "id", gotIndex.PrimaryKey)
+
+		gotSettings, err := index.GetSettings()
+		require.NoError(t, err)
+		require.Equal(t, []string{"genre", "author.name"}, gotSettings.FilterableAttributes)
+		require.Equal(t, []string{"title", "genre"}, gotSettings.SearchableAttributes)
+
+		// Ensuring the index again is a no-op
+		_, err = EnsureIndexFor[schemaBookTest](context.Background(), c, "TestEnsureIndexForCreate")
+		require.NoError(t, err)
+	})
+
+	t.Run("TestEnsureIndexForOtherPrimaryKey", func(t *testing.T) {
+		task, err := c.CreateIndex(&IndexConfig{Uid: "TestEnsureIndexForOtherPrimaryKey", PrimaryKey: "uid"})
+		require.NoError(t, err)
+		testWaitForTask(t, c.Index("TestEnsureIndexForOtherPrimaryKey"), task)
+
+		_, err = EnsureIndexFor[schemaBookTest](context.Background(), c, "TestEnsureIndexForOtherPrimaryKey")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `has primary key "uid" instead of "id"`)
+	})
+}
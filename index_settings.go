@@ -445,3 +445,348 @@ func (i Index) ResetSortableAttributes() (resp *Task, err error) {
 	}
 	return resp, nil
 }
+
+// GetTypoTolerance gets the typo tolerance settings of the index. It requires
+// Meilisearch v0.28 or later, an *UnsupportedError is returned by older
+// servers.
+func (i Index) GetTypoTolerance() (resp *TypoTolerance, err error) {
+	resp = &TypoTolerance{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/typo-tolerance",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetTypoTolerance",
+	}
+	if err := i.client.executeRequest(req); err != nil {
+		return nil, unsupportedError(err, "GetTypoTolerance", "v0.28")
+	}
+	return resp, nil
+}
+
+// UpdateTypoTolerance updates the typo tolerance settings of the index. It
+// requires Meilisearch v0.28 or later, an *UnsupportedError is returned by
+// older servers.
+func (i Index) UpdateTypoTolerance(request *TypoTolerance) (resp *Task, err error) {
+	resp = &Task{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/typo-tolerance",
+		method:              http.MethodPatch,
+		contentType:         contentTypeJSON,
+		withRequest:         &request,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "UpdateTypoTolerance",
+	}
+	if err := i.client.executeRequest(req); err != nil {
+		return nil, unsupportedError(err, "UpdateTypoTolerance", "v0.28")
+	}
+	return resp, nil
+}
+
+// ResetTypoTolerance resets the typo tolerance settings of the index to their
+// default value. It requires Meilisearch v0.28 or later, an *UnsupportedError
+// is returned by older servers.
+func (i Index) ResetTypoTolerance() (resp *Task, err error) {
+	resp = &Task{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/typo-tolerance",
+		method:              http.MethodDelete,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "ResetTypoTolerance",
+	}
+	if err := i.client.executeRequest(req); err != nil {
+		return nil, unsupportedError(err, "ResetTypoTolerance", "v0.28")
+	}
+	return resp, nil
+}
+
+// GetFaceting gets the faceting settings of the index. It requires Meilisearch
+// v0.28 or later, an *UnsupportedError is returned by older servers.
+func (i Index) GetFaceting() (resp *Faceting, err error) {
+	resp = &Faceting{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/faceting",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetFaceting",
+	}
+	if err := i.client.executeRequest(req); err != nil {
+		return nil, unsupportedError(err, "GetFaceting", "v0.28")
+	}
+	return resp, nil
+}
+
+// UpdateFaceting updates the faceting settings of the index. It requires
+// Meilisearch v0.28 or later, an *UnsupportedError is returned by older
+// servers.
+func (i Index) UpdateFaceting(request *Faceting) (resp *Task, err error) {
+	resp = &Task{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/faceting",
+		method:              http.MethodPatch,
+		contentType:         contentTypeJSON,
+		withRequest:         &request,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "UpdateFaceting",
+	}
+	if err := i.client.executeRequest(req); err != nil {
+		return nil, unsupportedError(err, "UpdateFaceting", "v0.28")
+	}
+	return resp, nil
+}
+
+// ResetFaceting resets the faceting settings of the index to their default
+// value. It requires Meilisearch v0.28 or later, an *UnsupportedError is
+// returned by older servers.
+func (i Index) ResetFaceting() (resp *Task, err error) {
+	resp = &Task{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/faceting",
+		method:              http.MethodDelete,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "ResetFaceting",
+	}
+	if err := i.client.executeRequest(req); err != nil {
+		return nil, unsupportedError(err, "ResetFaceting", "v0.28")
+	}
+	return resp, nil
+}
+
+// GetPagination gets the pagination settings of the index. It requires
+// Meilisearch v0.28 or later, an *UnsupportedError is returned by older
+// servers.
+func (i Index) GetPagination() (resp *Pagination, err error) {
+	resp = &Pagination{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/pagination",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetPagination",
+	}
+	if err := i.client.executeRequest(req); err != nil {
+		return nil, unsupportedError(err, "GetPagination", "v0.28")
+	}
+	return resp, nil
+}
+
+// UpdatePagination updates the pagination settings of the index. It requires
+// Meilisearch v0.28 or later, an *UnsupportedError is returned by older
+// servers.
+func (i Index) UpdatePagination(request *Pagination) (resp *Task, err error) {
+	resp = &Task{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/pagination",
+		method:              http.MethodPatch,
+		contentType:         contentTypeJSON,
+		withRequest:         &request,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "UpdatePagination",
+	}
+	if err := i.client.executeRequest(req); err != nil {
+		return nil, unsupportedError(err, "UpdatePagination", "v0.28")
+	}
+	return resp, nil
+}
+
+// ResetPagination resets the pagination settings of the index to their default
+// value. It requires Meilisearch v0.28 or later, an *UnsupportedError is
+// returned by older servers.
+func (i Index) ResetPagination() (resp *Task, err error) {
+	resp = &Task{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/pagination",
+		method:              http.MethodDelete,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "ResetPagination",
+	}
+	if err := i.client.executeRequest(req); err != nil {
+		return nil, unsupportedError(err, "ResetPagination", "v0.28")
+	}
+	return resp, nil
+}
+
+// GetSeparatorTokens gets the separator tokens settings of the index. It
+// requires Meilisearch v1.3 or later, an *UnsupportedError is returned by older
+// servers.
+func (i Index) GetSeparatorTokens() (resp *[]string, err error) {
+	resp = &[]string{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/separator-tokens",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetSeparatorTokens",
+	}
+	if err := i.client.executeRequest(req); err != nil {
+		return nil, unsupportedError(err, "GetSeparatorTokens", "v1.3")
+	}
+	return resp, nil
+}
+
+// UpdateSeparatorTokens updates the separator tokens settings of the index. It
+// requires Meilisearch v1.3 or later, an *UnsupportedError is returned by older
+// servers.
+func (i Index) UpdateSeparatorTokens(request *[]string) (resp *Task, err error) {
+	resp = &Task{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/separator-tokens",
+		method:              http.MethodPut,
+		contentType:         contentTypeJSON,
+		withRequest:         &request,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "UpdateSeparatorTokens",
+	}
+	if err := i.client.executeRequest(req); err != nil {
+		return nil, unsupportedError(err, "UpdateSeparatorTokens", "v1.3")
+	}
+	return resp, nil
+}
+
+// ResetSeparatorTokens resets the separator tokens settings of the index to
+// their default value. It requires Meilisearch v1.3 or later, an
+// *UnsupportedError is returned by older servers.
+func (i Index) ResetSeparatorTokens() (resp *Task, err error) {
+	resp = &Task{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/separator-tokens",
+		method:              http.MethodDelete,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "ResetSeparatorTokens",
+	}
+	if err := i.client.executeRequest(req); err != nil {
+		return nil, unsupportedError(err, "ResetSeparatorTokens", "v1.3")
+	}
+	return resp, nil
+}
+
+// GetNonSeparatorTokens gets the non-separator tokens settings of the index. It
+// requires Meilisearch v1.3 or later, an *UnsupportedError is returned by older
+// servers.
+func (i Index) GetNonSeparatorTokens() (resp *[]string, err error) {
+	resp = &[]string{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/non-separator-tokens",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetNonSeparatorTokens",
+	}
+	if err := i.client.executeRequest(req); err != nil {
+		return nil, unsupportedError(err, "GetNonSeparatorTokens", "v1.3")
+	}
+	return resp, nil
+}
+
+// UpdateNonSeparatorTokens updates the non-separator tokens settings of the
+// index. It requires Meilisearch v1.3 or later, an *UnsupportedError is
+// returned by older servers.
+func (i Index) UpdateNonSeparatorTokens(request *[]string) (resp *Task, err error) {
+	resp = &Task{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/non-separator-tokens",
+		method:              http.MethodPut,
+		contentType:         contentTypeJSON,
+		withRequest:         &request,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "UpdateNonSeparatorTokens",
+	}
+	if err := i.client.executeRequest(req); err != nil {
+		return nil, unsupportedError(err, "UpdateNonSeparatorTokens", "v1.3")
+	}
+	return resp, nil
+}
+
+// ResetNonSeparatorTokens resets the non-separator tokens settings of the index
+// to their default value. It requires Meilisearch v1.3 or later, an
+// *UnsupportedError is returned by older servers.
+func (i Index) ResetNonSeparatorTokens() (resp *Task, err error) {
+	resp = &Task{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/non-separator-tokens",
+		method:              http.MethodDelete,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "ResetNonSeparatorTokens",
+	}
+	if err := i.client.executeRequest(req); err != nil {
+		return nil, unsupportedError(err, "ResetNonSeparatorTokens", "v1.3")
+	}
+	return resp, nil
+}
+
+// GetDictionary gets the dictionary settings of the index. It requires
+// Meilisearch v1.3 or later, an *UnsupportedError is returned by older servers.
+func (i Index) GetDictionary() (resp *[]string, err error) {
+	resp = &[]string{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/dictionary",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetDictionary",
+	}
+	if err := i.client.executeRequest(req); err != nil {
+		return nil, unsupportedError(err, "GetDictionary", "v1.3")
+	}
+	return resp, nil
+}
+
+// UpdateDictionary updates the dictionary settings of the index. It requires
+// Meilisearch v1.3 or later, an *UnsupportedError is returned by older servers.
+func (i Index) UpdateDictionary(request *[]string) (resp *Task, err error) {
+	resp = &Task{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/dictionary",
+		method:              http.MethodPut,
+		contentType:         contentTypeJSON,
+		withRequest:         &request,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "UpdateDictionary",
+	}
+	if err := i.client.executeRequest(req); err != nil {
+		return nil, unsupportedError(err, "UpdateDictionary", "v1.3")
+	}
+	return resp, nil
+}
+
+// ResetDictionary resets the dictionary settings of the index to their default
+// value. It requires Meilisearch v1.3 or later, an *UnsupportedError is
+// returned by older servers.
+func (i Index) ResetDictionary() (resp *Task, err error) {
+	resp = &Task{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/dictionary",
+		method:              http.MethodDelete,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "ResetDictionary",
+	}
+	if err := i.client.executeRequest(req); err != nil {
+		return nil, unsupportedError(err, "ResetDictionary", "v1.3")
+	}
+	return resp, nil
+}
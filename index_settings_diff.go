@@ -0,0 +1,417 @@
+package meilisearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// defaultRankingRules are the ranking rules of an index whose ranking rules
+// were never updated
+var defaultRankingRules = FormatRankingRules(DefaultRankingRules())
+
+// SettingChange is the change of a single setting of a SettingsPlan
+type SettingChange struct {
+	// Setting is the name of the setting, as in the JSON of Settings
+	// (e.g. "rankingRules")
+	Setting string
+
+	// Reset is set if the setting is reset to its default value
+	Reset bool
+
+	// Current and Desired are the values of the setting before and after the
+	// change
+	Current interface{}
+	Desired interface{}
+
+	// Added and Removed are the elements added to and removed from the
+	// settings compared as sets: stop words, filterable and sortable
+	// attributes, separator and non-separator tokens, the dictionary and the
+	// words of synonyms
+	Added   []string
+	Removed []string
+
+	apply func(index Index) (*Task, error)
+}
+
+// String returns the change as a line of a plan, e.g.
+// `~ stopWords: + "a", - "the"`
+func (c SettingChange) String() string {
+	var change string
+	switch {
+	case c.Setting == "synonyms":
+		change = formatSynonymsChange(c.Current.(map[string][]string), c.Desired.(map[string][]string))
+	case c.Added != nil || c.Removed != nil:
+		change = formatSetChange(c.Added, c.Removed)
+	default:
+		change = formatSettingValue(c.Current) + " -> " + formatSettingValue(c.Desired)
+	}
+	if c.Reset {
+		change += " (reset)"
+	}
+	return "~ " + c.Setting + ": " + change
+}
+
+// SettingsPlan is the list of changes needed for the settings of an index to
+// go from their current values to desired ones, computed by DiffSettings and
+// executed by Apply.
+type SettingsPlan struct {
+	Changes []SettingChange
+}
+
+// DiffSettings compares current and desired field by field and returns the
+// plan changing current into desired:
+//   - a nil field of desired is left unchanged, while an empty list is reset
+//     to its default value
+//   - ranking rules, searchable and displayed attributes are compared in order
+//   - stop words, filterable and sortable attributes, separator and
+//     non-separator tokens and the dictionary are compared as sets
+//   - synonyms are compared word by word, the synonyms of a word as a set
+//   - only the fields set in the typo tolerance of desired are compared, like
+//     they are only updated: an empty list does not clear the words or the
+//     attributes typo tolerance is disabled on, use ResetTypoTolerance
+func DiffSettings(current *Settings, desired *Settings) *SettingsPlan {
+	plan := &SettingsPlan{}
+	plan.diffList("rankingRules", current.RankingRules, desired.RankingRules, defaultRankingRules, false, Index.UpdateRankingRules, Index.ResetRankingRules)
+	if desired.DistinctAttribute != nil && *desired.DistinctAttribute != stringOrEmpty(current.DistinctAttribute) {
+		distinct := *desired.DistinctAttribute
+		change := SettingChange{
+			Setting: "distinctAttribute",
+			Current: current.DistinctAttribute,
+			Desired: desired.DistinctAttribute,
+			Reset:   distinct == "",
+			apply:   Index.ResetDistinctAttribute,
+		}
+		if distinct != "" {
+			change.apply = func(index Index) (*Task, error) {
+				return index.UpdateDistinctAttribute(distinct)
+			}
+		}
+		plan.Changes = append(plan.Changes, change)
+	}
+	plan.diffList("searchableAttributes", current.SearchableAttributes, desired.SearchableAttributes, []string{"*"}, false, Index.UpdateSearchableAttributes, Index.ResetSearchableAttributes)
+	plan.diffList("displayedAttributes", current.DisplayedAttributes, desired.DisplayedAttributes, []string{"*"}, false, Index.UpdateDisplayedAttributes, Index.ResetDisplayedAttributes)
+	plan.diffList("stopWords", current.StopWords, desired.StopWords, nil, true, Index.UpdateStopWords, Index.ResetStopWords)
+	if desired.Synonyms != nil && !equalSynonyms(current.Synonyms, desired.Synonyms) {
+		synonyms := desired.Synonyms
+		change := SettingChange{
+			Setting: "synonyms",
+			Current: nonNilSynonyms(current.Synonyms),
+			Desired: nonNilSynonyms(synonyms),
+			Reset:   len(synonyms) == 0,
+			apply:   Index.ResetSynonyms,
+		}
+		change.Added, change.Removed = diffSets(synonymWords(current.Synonyms), synonymWords(synonyms))
+		if len(synonyms) != 0 {
+			change.apply = func(index Index) (*Task, error) {
+				return index.UpdateSynonyms(&synonyms)
+			}
+		}
+		plan.Changes = append(plan.Changes, change)
+	}
+	plan.diffList("filterableAttributes", current.FilterableAttributes, desired.FilterableAttributes, nil, true, Index.UpdateFilterableAttributes, Index.ResetFilterableAttributes)
+	plan.diffList("sortableAttributes", current.SortableAttributes, desired.SortableAttributes, nil, true, Index.UpdateSortableAttributes, Index.ResetSortableAttributes)
+	if desired.TypoTolerance != nil && !containsTypoTolerance(current.TypoTolerance, desired.TypoTolerance) {
+		typoTolerance := desired.TypoTolerance
+		plan.Changes = append(plan.Changes, SettingChange{
+			Setting: "typoTolerance",
+			Current: current.TypoTolerance,
+			Desired: typoTolerance,
+			apply: func(index Index) (*Task, error) {
+				return index.UpdateTypoTolerance(typoTolerance)
+			},
+		})
+	}
+	if desired.Faceting != nil && (current.Faceting == nil || *current.Faceting != *desired.Faceting) {
+		faceting := desired.Faceting
+		plan.Changes = append(plan.Changes, SettingChange{
+			Setting: "faceting",
+			Current: current.Faceting,
+			Desired: faceting,
+			apply: func(index Index) (*Task, error) {
+				return index.UpdateFaceting(faceting)
+			},
+		})
+	}
+	if desired.Pagination != nil && (current.Pagination == nil || *current.Pagination != *desired.Pagination) {
+		pagination := desired.Pagination
+		plan.Changes = append(plan.Changes, SettingChange{
+			Setting: "pagination",
+			Current: current.Pagination,
+			Desired: pagination,
+			apply: func(index Index) (*Task, error) {
+				return index.UpdatePagination(pagination)
+			},
+		})
+	}
+	plan.diffList("separatorTokens", current.SeparatorTokens, desired.SeparatorTokens, nil, true, Index.UpdateSeparatorTokens, Index.ResetSeparatorTokens)
+	plan.diffList("nonSeparatorTokens", current.NonSeparatorTokens, desired.NonSeparatorTokens, nil, true, Index.UpdateNonSeparatorTokens, Index.ResetNonSeparatorTokens)
+	plan.diffList("dictionary", current.Dictionary, desired.Dictionary, nil, true, Index.UpdateDictionary, Index.ResetDictionary)
+	return plan
+}
+
+// containsTypoTolerance reports whether the fields set in desired have the
+// same values in current, the fields left nil, zero or empty in desired being
+// omitted, and so unchanged, by an update
+func containsTypoTolerance(current, desired *TypoTolerance) bool {
+	if current == nil {
+		current = &TypoTolerance{}
+	}
+	if desired.Enabled != nil && (current.Enabled == nil || *current.Enabled != *desired.Enabled) {
+		return false
+	}
+	if desired.MinWordSizeForTypos != nil {
+		currentSizes := MinWordSizeForTypos{}
+		if current.MinWordSizeForTypos != nil {
+			currentSizes = *current.MinWordSizeForTypos
+		}
+		if desired.MinWordSizeForTypos.OneTypo != 0 && desired.MinWordSizeForTypos.OneTypo != currentSizes.OneTypo ||
+			desired.MinWordSizeForTypos.TwoTypos != 0 && desired.MinWordSizeForTypos.TwoTypos != currentSizes.TwoTypos {
+			return false
+		}
+	}
+	if len(desired.DisableOnWords) != 0 && !equalStrings(sortedStrings(current.DisableOnWords), sortedStrings(desired.DisableOnWords)) {
+		return false
+	}
+	if len(desired.DisableOnAttributes) != 0 && !equalStrings(sortedStrings(current.DisableOnAttributes), sortedStrings(desired.DisableOnAttributes)) {
+		return false
+	}
+	return true
+}
+
+// diffList adds the change of a list setting from current to desired to the
+// plan if they differ, an empty list standing for defaultList
+func (p *SettingsPlan) diffList(setting string, current, desired []string, defaultList []string, asSet bool, update func(Index, *[]string) (*Task, error), reset func(Index) (*Task, error)) {
+	if desired == nil {
+		return
+	}
+	from, to := current, desired
+	if len(from) == 0 {
+		from = defaultList
+	}
+	if len(to) == 0 {
+		to = defaultList
+	}
+	if asSet {
+		from, to = sortedStrings(from), sortedStrings(to)
+	}
+	if equalStrings(from, to) {
+		return
+	}
+
+	change := SettingChange{
+		Setting: setting,
+		Current: nonNilStrings(current),
+		Desired: nonNilStrings(desired),
+		Reset:   len(desired) == 0,
+		apply:   reset,
+	}
+	if asSet {
+		change.Added, change.Removed = diffSets(from, to)
+	}
+	if len(desired) != 0 {
+		change.apply = func(index Index) (*Task, error) {
+			return update(index, &desired)
+		}
+	}
+	p.Changes = append(p.Changes, change)
+}
+
+// IsEmpty reports whether the plan has no change
+func (p *SettingsPlan) IsEmpty() bool {
+	return len(p.Changes) == 0
+}
+
+// String returns the plan in a human-readable form, one change per line:
+//
+//	~ rankingRules: ["words", "typo"] -> ["typo", "words"]
+//	~ distinctAttribute: "isbn" -> none (reset)
+//	~ stopWords: + "a", - "the"
+//	~ synonyms: + "novel": ["book"], ~ "tale": ["story"] -> ["fable", "story"]
+func (p *SettingsPlan) String() string {
+	if p.IsEmpty() {
+		return "No changes."
+	}
+	lines := make([]string, len(p.Changes))
+	for j, change := range p.Changes {
+		lines[j] = change.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Apply executes the plan on index, calling the Update or Reset method of
+// each changed setting, and waits for the tasks to succeed. It returns the
+// processed tasks, in the order of the changes.
+// If a change cannot be enqueued or a task does not succeed, the tasks
+// enqueued so far are returned along with the error, in their last known
+// state, for the caller to track them.
+func (p *SettingsPlan) Apply(ctx context.Context, index *Index) ([]Task, error) {
+	resp := make([]Task, 0, len(p.Changes))
+	for _, change := range p.Changes {
+		task, err := change.apply(*index)
+		if err != nil {
+			return resp, errors.Wrapf(err, "could not update %s of index %q", change.Setting, index.UID)
+		}
+		resp = append(resp, *task)
+	}
+	for j := range resp {
+		finalTask, err := index.client.waitForTaskSucceeded(ctx, &resp[j])
+		if finalTask != nil {
+			resp[j] = *finalTask
+		}
+		if err != nil {
+			return resp, errors.Wrapf(err, "could not update %s of index %q", p.Changes[j].Setting, index.UID)
+		}
+	}
+	return resp, nil
+}
+
+// PlanSettings returns the plan changing the current settings of the index
+// into desired, see DiffSettings.
+func (i Index) PlanSettings(desired *Settings) (*SettingsPlan, error) {
+	current, err := i.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	return DiffSettings(current, desired), nil
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for j := range a {
+		if a[j] != b[j] {
+			return false
+		}
+	}
+	return true
+}
+
+// equalSynonyms reports whether the synonyms a and b are equal, the synonyms
+// of a word being compared as sets
+func equalSynonyms(a, b map[string][]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for word, synonyms := range a {
+		other, ok := b[word]
+		if !ok || !reflect.DeepEqual(sortedStrings(synonyms), sortedStrings(other)) {
+			return false
+		}
+	}
+	return true
+}
+
+// diffSets returns the elements of to missing from from and the ones of from
+// missing from to, both being sorted
+func diffSets(from, to []string) (added, removed []string) {
+	added, removed = []string{}, []string{}
+	j, k := 0, 0
+	for j < len(from) || k < len(to) {
+		switch {
+		case k == len(to) || (j < len(from) && from[j] < to[k]):
+			removed = append(removed, from[j])
+			j++
+		case j == len(from) || to[k] < from[j]:
+			added = append(added, to[k])
+			k++
+		default:
+			j++
+			k++
+		}
+	}
+	return added, removed
+}
+
+func synonymWords(synonyms map[string][]string) []string {
+	words := make([]string, 0, len(synonyms))
+	for word := range synonyms {
+		words = append(words, word)
+	}
+	sort.Strings(words)
+	return words
+}
+
+func formatSetChange(added, removed []string) string {
+	parts := make([]string, 0, len(added)+len(removed))
+	for _, element := range added {
+		parts = append(parts, fmt.Sprintf("+ %q", element))
+	}
+	for _, element := range removed {
+		parts = append(parts, fmt.Sprintf("- %q", element))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func formatSynonymsChange(current, desired map[string][]string) string {
+	var parts []string
+	for _, word := range synonymWords(desired) {
+		synonyms, ok := current[word]
+		if !ok {
+			parts = append(parts, fmt.Sprintf("+ %q: %s", word, formatSettingValue(desired[word])))
+		} else if !reflect.DeepEqual(sortedStrings(synonyms), sortedStrings(desired[word])) {
+			parts = append(parts, fmt.Sprintf("~ %q: %s -> %s", word, formatSettingValue(synonyms), formatSettingValue(desired[word])))
+		}
+	}
+	for _, word := range synonymWords(current) {
+		if _, ok := desired[word]; !ok {
+			parts = append(parts, fmt.Sprintf("- %q", word))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+func formatSettingValue(value interface{}) string {
+	switch value := value.(type) {
+	case *string:
+		if value == nil || *value == "" {
+			return "none"
+		}
+		return fmt.Sprintf("%q", *value)
+	case []string:
+		quoted := make([]string, len(value))
+		for j, element := range value {
+			quoted[j] = fmt.Sprintf("%q", element)
+		}
+		return "[" + strings.Join(quoted, ", ") + "]"
+	default:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Sprint(value)
+		}
+		return string(data)
+	}
+}
+
+func nonNilStrings(list []string) []string {
+	if list == nil {
+		return []string{}
+	}
+	return list
+}
+
+func nonNilSynonyms(synonyms map[string][]string) map[string][]string {
+	if synonyms == nil {
+		return map[string][]string{}
+	}
+	return synonyms
+}
+
+func sortedStrings(list []string) []string {
+	sorted := append([]string(nil), list...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
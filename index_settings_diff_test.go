@@ -0,0 +1,198 @@
+package meilisearch
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffSettings(t *testing.T) {
+	distinct := "isbn"
+	empty := ""
+	tests := []struct {
+		name     string
+		current  *Settings
+		desired  *Settings
+		want     []string
+		wantPlan string
+	}{
+		{
+			name:     "TestDiffSettingsNil",
+			current:  &Settings{StopWords: []string{"the"}, RankingRules: defaultRankingRules},
+			desired:  &Settings{},
+			wantPlan: "No changes.",
+		},
+		{
+			name:     "TestDiffSettingsEqual",
+			current:  &Settings{RankingRules: defaultRankingRules, SearchableAttributes: []string{"title", "author"}, StopWords: []string{"a", "the"}},
+			desired:  &Settings{RankingRules: []string{}, SearchableAttributes: []string{"title", "author"}, StopWords: []string{"the", "a"}},
+			wantPlan: "No changes.",
+		},
+		{
+			name:     "TestDiffSettingsOrder",
+			current:  &Settings{SearchableAttributes: []string{"title", "author"}, FilterableAttributes: []string{"genre", "year"}},
+			desired:  &Settings{SearchableAttributes: []string{"author", "title"}, FilterableAttributes: []string{"year", "genre"}},
+			want:     []string{"searchableAttributes"},
+			wantPlan: `~ searchableAttributes: ["title", "author"] -> ["author", "title"]`,
+		},
+		{
+			name:     "TestDiffSettingsDefaults",
+			current:  &Settings{SearchableAttributes: []string{"*"}, DisplayedAttributes: []string{"title"}, SortableAttributes: []string{}},
+			desired:  &Settings{SearchableAttributes: []string{}, DisplayedAttributes: []string{}, SortableAttributes: []string{}},
+			want:     []string{"displayedAttributes"},
+			wantPlan: `~ displayedAttributes: ["title"] -> [] (reset)`,
+		},
+		{
+			name:     "TestDiffSettingsSets",
+			current:  &Settings{StopWords: []string{"the", "of"}, FilterableAttributes: []string{"tag"}},
+			desired:  &Settings{StopWords: []string{"a", "of"}, FilterableAttributes: []string{}},
+			want:     []string{"stopWords", "filterableAttributes"},
+			wantPlan: "~ stopWords: + \"a\", - \"the\"\n~ filterableAttributes: - \"tag\" (reset)",
+		},
+		{
+			name:     "TestDiffSettingsDistinct",
+			current:  &Settings{},
+			desired:  &Settings{DistinctAttribute: &distinct},
+			want:     []string{"distinctAttribute"},
+			wantPlan: `~ distinctAttribute: none -> "isbn"`,
+		},
+		{
+			name:     "TestDiffSettingsResetDistinct",
+			current:  &Settings{DistinctAttribute: &distinct},
+			desired:  &Settings{DistinctAttribute: &empty},
+			want:     []string{"distinctAttribute"},
+			wantPlan: `~ distinctAttribute: "isbn" -> none (reset)`,
+		},
+		{
+			name: "TestDiffSettingsSynonyms",
+			current: &Settings{Synonyms: map[string][]string{
+				"tale":  {"story", "fable"},
+				"movie": {"film"},
+				"book":  {"novel"},
+			}},
+			desired: &Settings{Synonyms: map[string][]string{
+				"tale":  {"fable", "story", "myth"},
+				"novel": {"book"},
+				"book":  {"novel"},
+			}},
+			want:     []string{"synonyms"},
+			wantPlan: `~ synonyms: + "novel": ["book"], ~ "tale": ["story", "fable"] -> ["fable", "story", "myth"], - "movie"`,
+		},
+		{
+			name: "TestDiffSettingsTypoTolerance",
+			current: &Settings{TypoTolerance: &TypoTolerance{
+				Enabled:             boolPtr(true),
+				MinWordSizeForTypos: &MinWordSizeForTypos{OneTypo: 5, TwoTypos: 9},
+				DisableOnWords:      []string{"potter"},
+			}},
+			desired: &Settings{TypoTolerance: &TypoTolerance{
+				MinWordSizeForTypos: &MinWordSizeForTypos{OneTypo: 5},
+				DisableOnWords:      []string{},
+			}},
+			wantPlan: "No changes.",
+		},
+		{
+			name:     "TestDiffSettingsLimits",
+			current:  &Settings{TypoTolerance: &defaultTypoTolerance, Faceting: &defaultFaceting, Pagination: &defaultPagination},
+			desired:  &Settings{TypoTolerance: &TypoTolerance{Enabled: boolPtr(false)}, Faceting: &Faceting{MaxValuesPerFacet: 100}, Pagination: &Pagination{MaxTotalHits: 50}},
+			want:     []string{"typoTolerance", "pagination"},
+			wantPlan: "~ typoTolerance: {\"enabled\":true,\"minWordSizeForTypos\":{\"oneTypo\":5,\"twoTypos\":9}} -> {\"enabled\":false}\n~ pagination: {\"maxTotalHits\":1000} -> {\"maxTotalHits\":50}",
+		},
+		{
+			name:     "TestDiffSettingsTokens",
+			current:  &Settings{SeparatorTokens: []string{"|"}, NonSeparatorTokens: []string{}, Dictionary: []string{"J. K."}},
+			desired:  &Settings{SeparatorTokens: []string{}, NonSeparatorTokens: []string{"@"}, Dictionary: []string{"J. K."}},
+			want:     []string{"separatorTokens", "nonSeparatorTokens"},
+			wantPlan: "~ separatorTokens: - \"|\" (reset)\n~ nonSeparatorTokens: + \"@\"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := DiffSettings(tt.current, tt.desired)
+			var got []string
+			for _, change := range plan.Changes {
+				got = append(got, change.Setting)
+			}
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.want == nil, plan.IsEmpty())
+			require.Equal(t, tt.wantPlan, plan.String())
+		})
+	}
+}
+
+func TestSettingsPlan_Apply(t *testing.T) {
+	c := defaultClient
+	t.Cleanup(cleanup(c))
+
+	i := c.Index("TestSettingsPlanApply")
+	task, err := i.UpdateSettings(&Settings{
+		RankingRules:       []string{"sort", "words"},
+		StopWords:          []string{"the"},
+		SortableAttributes: []string{"year"},
+		Synonyms:           map[string][]string{"tale": {"story"}},
+	})
+	require.NoError(t, err)
+	testWaitForTask(t, i, task)
+
+	desired := &Settings{
+		RankingRules:         []string{},
+		StopWords:            []string{"the", "a"},
+		FilterableAttributes: []string{"genre"},
+		Synonyms:             map[string][]string{},
+	}
+	plan, err := i.PlanSettings(desired)
+	require.NoError(t, err)
+	require.Equal(t, "~ rankingRules: [\"sort\", \"words\"] -> [] (reset)\n"+
+		"~ stopWords: + \"a\"\n"+
+		"~ synonyms: - \"tale\" (reset)\n"+
+		"~ filterableAttributes: + \"genre\"", plan.String())
+
+	tasks, err := plan.Apply(context.Background(), i)
+	require.NoError(t, err)
+	require.Len(t, tasks, 4)
+	for _, task := range tasks {
+		require.Equal(t, TaskStatusSucceeded, task.Status)
+	}
+
+	gotSettings, err := i.GetSettings()
+	require.NoError(t, err)
+	require.Equal(t, defaultRankingRules, gotSettings.RankingRules)
+	require.ElementsMatch(t, []string{"the", "a"}, gotSettings.StopWords)
+	require.Equal(t, []string{"genre"}, gotSettings.FilterableAttributes)
+	require.Empty(t, gotSettings.Synonyms)
+	require.Equal(t, []string{"year"}, gotSettings.SortableAttributes)
+
+	plan, err = i.PlanSettings(desired)
+	require.NoError(t, err)
+	require.True(t, plan.IsEmpty())
+	tasks, err = plan.Apply(context.Background(), i)
+	require.NoError(t, err)
+	require.Empty(t, tasks)
+}
+
+func TestSettingsPlan_ApplyError(t *testing.T) {
+	c := defaultClient
+	t.Cleanup(cleanup(c))
+	i := c.Index("TestSettingsPlanApplyError")
+
+	plan := DiffSettings(&Settings{StopWords: []string{}}, &Settings{StopWords: []string{"the"}})
+	require.Len(t, plan.Changes, 1)
+	plan.Changes = append(plan.Changes, SettingChange{
+		Setting: "rankingRules",
+		apply: func(index Index) (*Task, error) {
+			return nil, errors.New("connection reset")
+		},
+	})
+
+	// The task already enqueued is returned
+	tasks, err := plan.Apply(context.Background(), i)
+	require.EqualError(t, err, `could not update rankingRules of index "TestSettingsPlanApplyError": connection reset`)
+	require.Len(t, tasks, 1)
+	testWaitForTask(t, i, &tasks[0])
+
+	gotStopWords, err := i.GetStopWords()
+	require.NoError(t, err)
+	require.Equal(t, &[]string{"the"}, gotStopWords)
+}
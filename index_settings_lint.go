@@ -0,0 +1,149 @@
+package meilisearch
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SettingsWarning is a suspicious setting reported by Index.LintSettings
+type SettingsWarning struct {
+	// Setting is the name of the setting, as in the JSON of Settings, with the
+	// position of the value in it (e.g. "filterableAttributes[1]")
+	Setting string
+
+	// Value is the suspicious value
+	Value string
+
+	// Message explains why the value is suspicious
+	Message string
+}
+
+// String returns the warning formatted as
+// `filterableAttributes[1]: "genre" is not a field of any document`
+func (w SettingsWarning) String() string {
+	return fmt.Sprintf("%s: %q %s", w.Setting, w.Value, w.Message)
+}
+
+// LintSettings cross-checks the settings of the index against the fields of
+// its documents, given by the FieldDistribution of GetStats, and reports:
+//   - searchable, displayed, filterable and sortable attributes, the
+//     distinct attribute and the attributes typo tolerance is disabled on,
+//     that are not a field of any document
+//   - custom ranking rules ("attribute:asc" or "attribute:desc") on attributes
+//     that are not sortable
+//   - synonyms whose words or synonyms are stop words, as stop words are
+//     ignored in queries
+//
+// Nested fields may be named with dots (e.g. "author.name"). Fields are not
+// checked while the index has no document. An empty slice is returned if
+// nothing is suspicious.
+func (i Index) LintSettings(ctx context.Context) ([]SettingsWarning, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	settings, err := i.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	stats, err := i.GetStats()
+	if err != nil {
+		return nil, err
+	}
+	return lintSettings(settings, stats), nil
+}
+
+func lintSettings(settings *Settings, stats *StatsIndex) []SettingsWarning {
+	warnings := []SettingsWarning{}
+
+	if stats.NumberOfDocuments != 0 {
+		fields := make([]string, 0, len(stats.FieldDistribution))
+		for field := range stats.FieldDistribution {
+			fields = append(fields, field)
+		}
+		checkFields := func(setting string, attributes []string) {
+			for j, attribute := range attributes {
+				if attribute != "*" && !isDocumentField(attribute, fields) {
+					warnings = append(warnings, SettingsWarning{
+						Setting: fmt.Sprintf("%s[%d]", setting, j),
+						Value:   attribute,
+						Message: "is not a field of any document",
+					})
+				}
+			}
+		}
+		checkFields("searchableAttributes", settings.SearchableAttributes)
+		checkFields("displayedAttributes", settings.DisplayedAttributes)
+		checkFields("filterableAttributes", settings.FilterableAttributes)
+		checkFields("sortableAttributes", settings.SortableAttributes)
+		if settings.TypoTolerance != nil {
+			checkFields("typoTolerance.disableOnAttributes", settings.TypoTolerance.DisableOnAttributes)
+		}
+		if settings.DistinctAttribute != nil && *settings.DistinctAttribute != "" && !isDocumentField(*settings.DistinctAttribute, fields) {
+			warnings = append(warnings, SettingsWarning{
+				Setting: "distinctAttribute",
+				Value:   *settings.DistinctAttribute,
+				Message: "is not a field of any document",
+			})
+		}
+	}
+
+	sortable := make(map[string]bool, len(settings.SortableAttributes))
+	for _, attribute := range settings.SortableAttributes {
+		sortable[attribute] = true
+	}
+	for j, rule := range settings.RankingRules {
+		attribute, _, ok := RankingRule(rule).Attribute()
+		if ok && !sortable[attribute] {
+			warnings = append(warnings, SettingsWarning{
+				Setting: fmt.Sprintf("rankingRules[%d]", j),
+				Value:   rule,
+				Message: fmt.Sprintf("sorts on %q which is not sortable", attribute),
+			})
+		}
+	}
+
+	stopWords := make(map[string]bool, len(settings.StopWords))
+	for _, word := range settings.StopWords {
+		stopWords[strings.ToLower(word)] = true
+	}
+	words := make([]string, 0, len(settings.Synonyms))
+	for word := range settings.Synonyms {
+		words = append(words, word)
+	}
+	sort.Strings(words)
+	for _, word := range words {
+		if stopWords[strings.ToLower(word)] {
+			warnings = append(warnings, SettingsWarning{
+				Setting: "synonyms",
+				Value:   word,
+				Message: "is a stop word",
+			})
+		}
+		for j, synonym := range settings.Synonyms[word] {
+			if stopWords[strings.ToLower(synonym)] {
+				warnings = append(warnings, SettingsWarning{
+					Setting: fmt.Sprintf("synonyms[%q][%d]", word, j),
+					Value:   synonym,
+					Message: "is a stop word",
+				})
+			}
+		}
+	}
+	return warnings
+}
+
+// isDocumentField reports whether attribute is one of fields, a field nested
+// in one of them or an object holding one of them
+func isDocumentField(attribute string, fields []string) bool {
+	for _, field := range fields {
+		if attribute == field || strings.HasPrefix(attribute, field+".") || strings.HasPrefix(field, attribute+".") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package meilisearch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndex_LintSettings(t *testing.T) {
+	c := defaultClient
+	t.Cleanup(cleanup(c))
+
+	i := c.Index("TestIndexLintSettings")
+	testAddIteratedDocuments(t, i)
+	distinct := "isbn"
+	task, err := i.UpdateSettings(&Settings{
+		RankingRules:         []string{"words", "year:desc", "rating:asc"},
+		DistinctAttribute:    &distinct,
+		SearchableAttributes: []string{"title", "summary"},
+		DisplayedAttributes:  []string{"*"},
+		FilterableAttributes: []string{"tag", "genre"},
+		SortableAttributes:   []string{"year"},
+		StopWords:            []string{"the", "of"},
+		Synonyms:             map[string][]string{"tale": {"story", "The"}, "of": {"from"}},
+	})
+	require.NoError(t, err)
+	testWaitForTask(t, i, task)
+
+	got, err := i.LintSettings(context.Background())
+	require.NoError(t, err)
+	var gotLines []string
+	for _, warning := range got {
+		gotLines = append(gotLines, warning.String())
+	}
+	require.Equal(t, []string{
+		`searchableAttributes[1]: "summary" is not a field of any document`,
+		`filterableAttributes[1]: "genre" is not a field of any document`,
+		`distinctAttribute: "isbn" is not a field of any document`,
+		`rankingRules[2]: "rating:asc" sorts on "rating" which is not sortable`,
+		`synonyms: "of" is a stop word`,
+		`synonyms["tale"][1]: "The" is a stop word`,
+	}, gotLines)
+
+	t.Run("TestIndexLintSettingsClean", func(t *testing.T) {
+		i := c.Index("TestIndexLintSettingsClean")
+		testAddIteratedDocuments(t, i)
+		task, err := i.UpdateSettings(&Settings{
+			RankingRules:         []string{"words", "year:desc"},
+			FilterableAttributes: []string{"tag"},
+			SortableAttributes:   []string{"year"},
+		})
+		require.NoError(t, err)
+		testWaitForTask(t, i, task)
+
+		got, err := i.LintSettings(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, got)
+	})
+
+	t.Run("TestIndexLintSettingsMissingIndex", func(t *testing.T) {
+		_, err := c.Index("TestIndexLintSettingsMissingIndex").LintSettings(context.Background())
+		require.ErrorIs(t, err, ErrIndexNotFound)
+	})
+}
+
+func TestLintSettingsNestedFields(t *testing.T) {
+	stats := &StatsIndex{
+		NumberOfDocuments: 2,
+		FieldDistribution: map[string]int64{"id": 2, "author": 2, "price.amount": 1},
+	}
+	got := lintSettings(&Settings{
+		FilterableAttributes: []string{"author.name", "price", "price.currency", "author_name"},
+	}, stats)
+	require.Equal(t, []SettingsWarning{
+		{Setting: "filterableAttributes[2]", Value: "price.currency", Message: "is not a field of any document"},
+		{Setting: "filterableAttributes[3]", Value: "author_name", Message: "is not a field of any document"},
+	}, got)
+
+	// Fields are not checked without documents
+	got = lintSettings(&Settings{FilterableAttributes: []string{"genre"}}, &StatsIndex{})
+	require.Empty(t, got)
+}
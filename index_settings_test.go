@@ -6,6 +6,24 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+var (
+	defaultTypoTolerance = TypoTolerance{
+		Enabled: boolPtr(true),
+		MinWordSizeForTypos: &MinWordSizeForTypos{
+			OneTypo:  5,
+			TwoTypos: 9,
+		},
+		DisableOnWords:      []string{},
+		DisableOnAttributes: []string{},
+	}
+	defaultFaceting   = Faceting{MaxValuesPerFacet: 100}
+	defaultPagination = Pagination{MaxTotalHits: 1000}
+)
+
+func boolPtr(v bool) *bool {
+	return &v
+}
+
 func TestIndex_GetFilterableAttributes(t *testing.T) {
 	type args struct {
 		UID    string
@@ -230,6 +248,12 @@ func TestIndex_GetSettings(t *testing.T) {
 				Synonyms:             map[string][]string(nil),
 				FilterableAttributes: []string{},
 				SortableAttributes:   []string{},
+				TypoTolerance:        &defaultTypoTolerance,
+				Faceting:             &defaultFaceting,
+				Pagination:           &defaultPagination,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
 			},
 		},
 		{
@@ -247,6 +271,12 @@ func TestIndex_GetSettings(t *testing.T) {
 				Synonyms:             map[string][]string(nil),
 				FilterableAttributes: []string{},
 				SortableAttributes:   []string{},
+				TypoTolerance:        &defaultTypoTolerance,
+				Faceting:             &defaultFaceting,
+				Pagination:           &defaultPagination,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
 			},
 		},
 	}
@@ -666,6 +696,12 @@ func TestIndex_ResetSettings(t *testing.T) {
 				Synonyms:             map[string][]string(nil),
 				FilterableAttributes: []string{},
 				SortableAttributes:   []string{},
+				TypoTolerance:        &defaultTypoTolerance,
+				Faceting:             &defaultFaceting,
+				Pagination:           &defaultPagination,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
 			},
 		},
 		{
@@ -686,6 +722,12 @@ func TestIndex_ResetSettings(t *testing.T) {
 				Synonyms:             map[string][]string(nil),
 				FilterableAttributes: []string{},
 				SortableAttributes:   []string{},
+				TypoTolerance:        &defaultTypoTolerance,
+				Faceting:             &defaultFaceting,
+				Pagination:           &defaultPagination,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
 			},
 		},
 	}
@@ -1222,6 +1264,20 @@ func TestIndex_UpdateSettings(t *testing.T) {
 					SortableAttributes: []string{
 						"title",
 					},
+					TypoTolerance: &TypoTolerance{
+						Enabled: boolPtr(true),
+						MinWordSizeForTypos: &MinWordSizeForTypos{
+							OneTypo:  4,
+							TwoTypos: 8,
+						},
+						DisableOnWords:      []string{"potter"},
+						DisableOnAttributes: []string{"tag"},
+					},
+					Faceting:           &Faceting{MaxValuesPerFacet: 200},
+					Pagination:         &Pagination{MaxTotalHits: 500},
+					SeparatorTokens:    []string{"|"},
+					NonSeparatorTokens: []string{"@"},
+					Dictionary:         []string{"J. K."},
 				},
 			},
 			wantTask: &Task{
@@ -1236,6 +1292,12 @@ func TestIndex_UpdateSettings(t *testing.T) {
 				Synonyms:             map[string][]string(nil),
 				FilterableAttributes: []string{},
 				SortableAttributes:   []string{},
+				TypoTolerance:        &defaultTypoTolerance,
+				Faceting:             &defaultFaceting,
+				Pagination:           &defaultPagination,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
 			},
 		},
 		{
@@ -1266,6 +1328,20 @@ func TestIndex_UpdateSettings(t *testing.T) {
 					SortableAttributes: []string{
 						"title",
 					},
+					TypoTolerance: &TypoTolerance{
+						Enabled: boolPtr(true),
+						MinWordSizeForTypos: &MinWordSizeForTypos{
+							OneTypo:  4,
+							TwoTypos: 8,
+						},
+						DisableOnWords:      []string{"potter"},
+						DisableOnAttributes: []string{"tag"},
+					},
+					Faceting:           &Faceting{MaxValuesPerFacet: 200},
+					Pagination:         &Pagination{MaxTotalHits: 500},
+					SeparatorTokens:    []string{"|"},
+					NonSeparatorTokens: []string{"@"},
+					Dictionary:         []string{"J. K."},
 				},
 			},
 			wantTask: &Task{
@@ -1280,6 +1356,12 @@ func TestIndex_UpdateSettings(t *testing.T) {
 				Synonyms:             map[string][]string(nil),
 				FilterableAttributes: []string{},
 				SortableAttributes:   []string{},
+				TypoTolerance:        &defaultTypoTolerance,
+				Faceting:             &defaultFaceting,
+				Pagination:           &defaultPagination,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
 			},
 		},
 	}
@@ -1347,6 +1429,12 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					},
 					FilterableAttributes: []string{},
 					SortableAttributes:   []string{},
+					TypoTolerance:        &defaultTypoTolerance,
+					Faceting:             &defaultFaceting,
+					Pagination:           &defaultPagination,
+					SeparatorTokens:      []string{},
+					NonSeparatorTokens:   []string{},
+					Dictionary:           []string{},
 				},
 				secondRequest: Settings{
 					Synonyms: map[string][]string{
@@ -1366,6 +1454,12 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					},
 					FilterableAttributes: []string{},
 					SortableAttributes:   []string{},
+					TypoTolerance:        &defaultTypoTolerance,
+					Faceting:             &defaultFaceting,
+					Pagination:           &defaultPagination,
+					SeparatorTokens:      []string{},
+					NonSeparatorTokens:   []string{},
+					Dictionary:           []string{},
 				},
 			},
 			wantTask: &Task{
@@ -1380,6 +1474,12 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 				Synonyms:             map[string][]string(nil),
 				FilterableAttributes: []string{},
 				SortableAttributes:   []string{},
+				TypoTolerance:        &defaultTypoTolerance,
+				Faceting:             &defaultFaceting,
+				Pagination:           &defaultPagination,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
 			},
 		},
 		{
@@ -1408,6 +1508,12 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					},
 					FilterableAttributes: []string{},
 					SortableAttributes:   []string{},
+					TypoTolerance:        &defaultTypoTolerance,
+					Faceting:             &defaultFaceting,
+					Pagination:           &defaultPagination,
+					SeparatorTokens:      []string{},
+					NonSeparatorTokens:   []string{},
+					Dictionary:           []string{},
 				},
 				secondRequest: Settings{
 					Synonyms: map[string][]string{
@@ -1427,6 +1533,12 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					},
 					FilterableAttributes: []string{},
 					SortableAttributes:   []string{},
+					TypoTolerance:        &defaultTypoTolerance,
+					Faceting:             &defaultFaceting,
+					Pagination:           &defaultPagination,
+					SeparatorTokens:      []string{},
+					NonSeparatorTokens:   []string{},
+					Dictionary:           []string{},
 				},
 			},
 			wantTask: &Task{
@@ -1441,6 +1553,12 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 				Synonyms:             map[string][]string(nil),
 				FilterableAttributes: []string{},
 				SortableAttributes:   []string{},
+				TypoTolerance:        &defaultTypoTolerance,
+				Faceting:             &defaultFaceting,
+				Pagination:           &defaultPagination,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
 			},
 		},
 		{
@@ -1469,6 +1587,12 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					Synonyms:             map[string][]string(nil),
 					FilterableAttributes: []string{},
 					SortableAttributes:   []string{},
+					TypoTolerance:        &defaultTypoTolerance,
+					Faceting:             &defaultFaceting,
+					Pagination:           &defaultPagination,
+					SeparatorTokens:      []string{},
+					NonSeparatorTokens:   []string{},
+					Dictionary:           []string{},
 				},
 				secondRequest: Settings{
 					SearchableAttributes: []string{
@@ -1488,6 +1612,12 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					Synonyms:             map[string][]string(nil),
 					FilterableAttributes: []string{},
 					SortableAttributes:   []string{},
+					TypoTolerance:        &defaultTypoTolerance,
+					Faceting:             &defaultFaceting,
+					Pagination:           &defaultPagination,
+					SeparatorTokens:      []string{},
+					NonSeparatorTokens:   []string{},
+					Dictionary:           []string{},
 				},
 			},
 			wantTask: &Task{
@@ -1502,6 +1632,12 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 				Synonyms:             map[string][]string(nil),
 				FilterableAttributes: []string{},
 				SortableAttributes:   []string{},
+				TypoTolerance:        &defaultTypoTolerance,
+				Faceting:             &defaultFaceting,
+				Pagination:           &defaultPagination,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
 			},
 		},
 		{
@@ -1530,6 +1666,12 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					Synonyms:             map[string][]string(nil),
 					FilterableAttributes: []string{},
 					SortableAttributes:   []string{},
+					TypoTolerance:        &defaultTypoTolerance,
+					Faceting:             &defaultFaceting,
+					Pagination:           &defaultPagination,
+					SeparatorTokens:      []string{},
+					NonSeparatorTokens:   []string{},
+					Dictionary:           []string{},
 				},
 				secondRequest: Settings{
 					DisplayedAttributes: []string{
@@ -1549,6 +1691,12 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					Synonyms:             map[string][]string(nil),
 					FilterableAttributes: []string{},
 					SortableAttributes:   []string{},
+					TypoTolerance:        &defaultTypoTolerance,
+					Faceting:             &defaultFaceting,
+					Pagination:           &defaultPagination,
+					SeparatorTokens:      []string{},
+					NonSeparatorTokens:   []string{},
+					Dictionary:           []string{},
 				},
 			},
 			wantTask: &Task{
@@ -1563,6 +1711,12 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 				Synonyms:             map[string][]string(nil),
 				FilterableAttributes: []string{},
 				SortableAttributes:   []string{},
+				TypoTolerance:        &defaultTypoTolerance,
+				Faceting:             &defaultFaceting,
+				Pagination:           &defaultPagination,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
 			},
 		},
 		{
@@ -1591,6 +1745,12 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					Synonyms:             map[string][]string(nil),
 					FilterableAttributes: []string{},
 					SortableAttributes:   []string{},
+					TypoTolerance:        &defaultTypoTolerance,
+					Faceting:             &defaultFaceting,
+					Pagination:           &defaultPagination,
+					SeparatorTokens:      []string{},
+					NonSeparatorTokens:   []string{},
+					Dictionary:           []string{},
 				},
 				secondRequest: Settings{
 					StopWords: []string{
@@ -1610,6 +1770,12 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					Synonyms:             map[string][]string(nil),
 					FilterableAttributes: []string{},
 					SortableAttributes:   []string{},
+					TypoTolerance:        &defaultTypoTolerance,
+					Faceting:             &defaultFaceting,
+					Pagination:           &defaultPagination,
+					SeparatorTokens:      []string{},
+					NonSeparatorTokens:   []string{},
+					Dictionary:           []string{},
 				},
 			},
 			wantTask: &Task{
@@ -1624,6 +1790,12 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 				Synonyms:             map[string][]string(nil),
 				FilterableAttributes: []string{},
 				SortableAttributes:   []string{},
+				TypoTolerance:        &defaultTypoTolerance,
+				Faceting:             &defaultFaceting,
+				Pagination:           &defaultPagination,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
 			},
 		},
 		{
@@ -1652,6 +1824,12 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 						"title",
 					},
 					SortableAttributes: []string{},
+					TypoTolerance:      &defaultTypoTolerance,
+					Faceting:           &defaultFaceting,
+					Pagination:         &defaultPagination,
+					SeparatorTokens:    []string{},
+					NonSeparatorTokens: []string{},
+					Dictionary:         []string{},
 				},
 				secondRequest: Settings{
 					FilterableAttributes: []string{
@@ -1671,6 +1849,12 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 						"title",
 					},
 					SortableAttributes: []string{},
+					TypoTolerance:      &defaultTypoTolerance,
+					Faceting:           &defaultFaceting,
+					Pagination:         &defaultPagination,
+					SeparatorTokens:    []string{},
+					NonSeparatorTokens: []string{},
+					Dictionary:         []string{},
 				},
 			},
 			wantTask: &Task{
@@ -1685,6 +1869,12 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 				Synonyms:             map[string][]string(nil),
 				FilterableAttributes: []string{},
 				SortableAttributes:   []string{},
+				TypoTolerance:        &defaultTypoTolerance,
+				Faceting:             &defaultFaceting,
+				Pagination:           &defaultPagination,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
 			},
 		},
 		{
@@ -1713,6 +1903,12 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					SortableAttributes: []string{
 						"title",
 					},
+					TypoTolerance:      &defaultTypoTolerance,
+					Faceting:           &defaultFaceting,
+					Pagination:         &defaultPagination,
+					SeparatorTokens:    []string{},
+					NonSeparatorTokens: []string{},
+					Dictionary:         []string{},
 				},
 				secondRequest: Settings{
 					SortableAttributes: []string{
@@ -1732,6 +1928,12 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					SortableAttributes: []string{
 						"title",
 					},
+					TypoTolerance:      &defaultTypoTolerance,
+					Faceting:           &defaultFaceting,
+					Pagination:         &defaultPagination,
+					SeparatorTokens:    []string{},
+					NonSeparatorTokens: []string{},
+					Dictionary:         []string{},
 				},
 			},
 			wantTask: &Task{
@@ -1746,6 +1948,12 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 				Synonyms:             map[string][]string(nil),
 				FilterableAttributes: []string{},
 				SortableAttributes:   []string{},
+				TypoTolerance:        &defaultTypoTolerance,
+				Faceting:             &defaultFaceting,
+				Pagination:           &defaultPagination,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
 			},
 		},
 	}
@@ -1964,3 +2172,978 @@ func TestIndex_UpdateSortableAttributes(t *testing.T) {
 		})
 	}
 }
+
+func TestIndex_GetTypoTolerance(t *testing.T) {
+	type args struct {
+		UID    string
+		client *Client
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantResp *TypoTolerance
+	}{
+		{
+			name: "TestIndexBasicGetTypoTolerance",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+			},
+			wantResp: &defaultTypoTolerance,
+		},
+		{
+			name: "TestIndexGetTypoToleranceWithCustomClient",
+			args: args{
+				UID:    "indexUID",
+				client: customClient,
+			},
+			wantResp: &defaultTypoTolerance,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotResp, err := i.GetTypoTolerance()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, gotResp)
+		})
+	}
+}
+
+func TestIndex_UpdateTypoTolerance(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request *TypoTolerance
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *Task
+	}{
+		{
+			name: "TestIndexBasicUpdateTypoTolerance",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+				request: &TypoTolerance{
+					Enabled: boolPtr(false),
+					MinWordSizeForTypos: &MinWordSizeForTypos{
+						OneTypo:  3,
+						TwoTypos: 7,
+					},
+					DisableOnWords:      []string{"potter"},
+					DisableOnAttributes: []string{"tag"},
+				},
+			},
+			wantTask: &Task{
+				UID: 1,
+			},
+		},
+		{
+			name: "TestIndexUpdateTypoToleranceWithCustomClient",
+			args: args{
+				UID:    "indexUID",
+				client: customClient,
+				request: &TypoTolerance{
+					Enabled: boolPtr(false),
+					MinWordSizeForTypos: &MinWordSizeForTypos{
+						OneTypo:  3,
+						TwoTypos: 7,
+					},
+					DisableOnWords:      []string{"potter"},
+					DisableOnAttributes: []string{"tag"},
+				},
+			},
+			wantTask: &Task{
+				UID: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotTask, err := i.UpdateTypoTolerance(tt.args.request)
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.UID, tt.wantTask.UID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetTypoTolerance()
+			require.NoError(t, err)
+			require.Equal(t, tt.args.request, gotResp)
+		})
+	}
+}
+
+func TestIndex_ResetTypoTolerance(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request *TypoTolerance
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *Task
+		wantResp *TypoTolerance
+	}{
+		{
+			name: "TestIndexBasicResetTypoTolerance",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+				request: &TypoTolerance{
+					Enabled: boolPtr(false),
+					MinWordSizeForTypos: &MinWordSizeForTypos{
+						OneTypo:  3,
+						TwoTypos: 7,
+					},
+					DisableOnWords:      []string{"potter"},
+					DisableOnAttributes: []string{"tag"},
+				},
+			},
+			wantTask: &Task{
+				UID: 1,
+			},
+			wantResp: &defaultTypoTolerance,
+		},
+		{
+			name: "TestIndexResetTypoToleranceWithCustomClient",
+			args: args{
+				UID:    "indexUID",
+				client: customClient,
+				request: &TypoTolerance{
+					Enabled: boolPtr(false),
+					MinWordSizeForTypos: &MinWordSizeForTypos{
+						OneTypo:  3,
+						TwoTypos: 7,
+					},
+					DisableOnWords:      []string{"potter"},
+					DisableOnAttributes: []string{"tag"},
+				},
+			},
+			wantTask: &Task{
+				UID: 1,
+			},
+			wantResp: &defaultTypoTolerance,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotTask, err := i.UpdateTypoTolerance(tt.args.request)
+			require.NoError(t, err)
+			testWaitForTask(t, i, gotTask)
+
+			gotTask, err = i.ResetTypoTolerance()
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.UID, tt.wantTask.UID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetTypoTolerance()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, gotResp)
+		})
+	}
+}
+
+func TestIndex_GetFaceting(t *testing.T) {
+	type args struct {
+		UID    string
+		client *Client
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantResp *Faceting
+	}{
+		{
+			name: "TestIndexBasicGetFaceting",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+			},
+			wantResp: &defaultFaceting,
+		},
+		{
+			name: "TestIndexGetFacetingWithCustomClient",
+			args: args{
+				UID:    "indexUID",
+				client: customClient,
+			},
+			wantResp: &defaultFaceting,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotResp, err := i.GetFaceting()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, gotResp)
+		})
+	}
+}
+
+func TestIndex_UpdateFaceting(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request *Faceting
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *Task
+	}{
+		{
+			name: "TestIndexBasicUpdateFaceting",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				request: &Faceting{MaxValuesPerFacet: 200},
+			},
+			wantTask: &Task{
+				UID: 1,
+			},
+		},
+		{
+			name: "TestIndexUpdateFacetingWithCustomClient",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				request: &Faceting{MaxValuesPerFacet: 200},
+			},
+			wantTask: &Task{
+				UID: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotTask, err := i.UpdateFaceting(tt.args.request)
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.UID, tt.wantTask.UID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetFaceting()
+			require.NoError(t, err)
+			require.Equal(t, tt.args.request, gotResp)
+		})
+	}
+}
+
+func TestIndex_ResetFaceting(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request *Faceting
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *Task
+		wantResp *Faceting
+	}{
+		{
+			name: "TestIndexBasicResetFaceting",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				request: &Faceting{MaxValuesPerFacet: 200},
+			},
+			wantTask: &Task{
+				UID: 1,
+			},
+			wantResp: &defaultFaceting,
+		},
+		{
+			name: "TestIndexResetFacetingWithCustomClient",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				request: &Faceting{MaxValuesPerFacet: 200},
+			},
+			wantTask: &Task{
+				UID: 1,
+			},
+			wantResp: &defaultFaceting,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotTask, err := i.UpdateFaceting(tt.args.request)
+			require.NoError(t, err)
+			testWaitForTask(t, i, gotTask)
+
+			gotTask, err = i.ResetFaceting()
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.UID, tt.wantTask.UID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetFaceting()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, gotResp)
+		})
+	}
+}
+
+func TestIndex_GetPagination(t *testing.T) {
+	type args struct {
+		UID    string
+		client *Client
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantResp *Pagination
+	}{
+		{
+			name: "TestIndexBasicGetPagination",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+			},
+			wantResp: &defaultPagination,
+		},
+		{
+			name: "TestIndexGetPaginationWithCustomClient",
+			args: args{
+				UID:    "indexUID",
+				client: customClient,
+			},
+			wantResp: &defaultPagination,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotResp, err := i.GetPagination()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, gotResp)
+		})
+	}
+}
+
+func TestIndex_UpdatePagination(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request *Pagination
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *Task
+	}{
+		{
+			name: "TestIndexBasicUpdatePagination",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				request: &Pagination{MaxTotalHits: 500},
+			},
+			wantTask: &Task{
+				UID: 1,
+			},
+		},
+		{
+			name: "TestIndexUpdatePaginationWithCustomClient",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				request: &Pagination{MaxTotalHits: 500},
+			},
+			wantTask: &Task{
+				UID: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotTask, err := i.UpdatePagination(tt.args.request)
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.UID, tt.wantTask.UID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetPagination()
+			require.NoError(t, err)
+			require.Equal(t, tt.args.request, gotResp)
+		})
+	}
+}
+
+func TestIndex_ResetPagination(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request *Pagination
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *Task
+		wantResp *Pagination
+	}{
+		{
+			name: "TestIndexBasicResetPagination",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				request: &Pagination{MaxTotalHits: 500},
+			},
+			wantTask: &Task{
+				UID: 1,
+			},
+			wantResp: &defaultPagination,
+		},
+		{
+			name: "TestIndexResetPaginationWithCustomClient",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				request: &Pagination{MaxTotalHits: 500},
+			},
+			wantTask: &Task{
+				UID: 1,
+			},
+			wantResp: &defaultPagination,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotTask, err := i.UpdatePagination(tt.args.request)
+			require.NoError(t, err)
+			testWaitForTask(t, i, gotTask)
+
+			gotTask, err = i.ResetPagination()
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.UID, tt.wantTask.UID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetPagination()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, gotResp)
+		})
+	}
+}
+
+func TestIndex_GetSeparatorTokens(t *testing.T) {
+	type args struct {
+		UID    string
+		client *Client
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantResp *[]string
+	}{
+		{
+			name: "TestIndexBasicGetSeparatorTokens",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+			},
+			wantResp: &[]string{},
+		},
+		{
+			name: "TestIndexGetSeparatorTokensWithCustomClient",
+			args: args{
+				UID:    "indexUID",
+				client: customClient,
+			},
+			wantResp: &[]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotResp, err := i.GetSeparatorTokens()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, gotResp)
+		})
+	}
+}
+
+func TestIndex_UpdateSeparatorTokens(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request *[]string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *Task
+	}{
+		{
+			name: "TestIndexBasicUpdateSeparatorTokens",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				request: &[]string{"|", "&hellip;"},
+			},
+			wantTask: &Task{
+				UID: 1,
+			},
+		},
+		{
+			name: "TestIndexUpdateSeparatorTokensWithCustomClient",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				request: &[]string{"|", "&hellip;"},
+			},
+			wantTask: &Task{
+				UID: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotTask, err := i.UpdateSeparatorTokens(tt.args.request)
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.UID, tt.wantTask.UID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetSeparatorTokens()
+			require.NoError(t, err)
+			require.Equal(t, tt.args.request, gotResp)
+		})
+	}
+}
+
+func TestIndex_ResetSeparatorTokens(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request *[]string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *Task
+		wantResp *[]string
+	}{
+		{
+			name: "TestIndexBasicResetSeparatorTokens",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				request: &[]string{"|", "&hellip;"},
+			},
+			wantTask: &Task{
+				UID: 1,
+			},
+			wantResp: &[]string{},
+		},
+		{
+			name: "TestIndexResetSeparatorTokensWithCustomClient",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				request: &[]string{"|", "&hellip;"},
+			},
+			wantTask: &Task{
+				UID: 1,
+			},
+			wantResp: &[]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotTask, err := i.UpdateSeparatorTokens(tt.args.request)
+			require.NoError(t, err)
+			testWaitForTask(t, i, gotTask)
+
+			gotTask, err = i.ResetSeparatorTokens()
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.UID, tt.wantTask.UID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetSeparatorTokens()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, gotResp)
+		})
+	}
+}
+
+func TestIndex_GetNonSeparatorTokens(t *testing.T) {
+	type args struct {
+		UID    string
+		client *Client
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantResp *[]string
+	}{
+		{
+			name: "TestIndexBasicGetNonSeparatorTokens",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+			},
+			wantResp: &[]string{},
+		},
+		{
+			name: "TestIndexGetNonSeparatorTokensWithCustomClient",
+			args: args{
+				UID:    "indexUID",
+				client: customClient,
+			},
+			wantResp: &[]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotResp, err := i.GetNonSeparatorTokens()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, gotResp)
+		})
+	}
+}
+
+func TestIndex_UpdateNonSeparatorTokens(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request *[]string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *Task
+	}{
+		{
+			name: "TestIndexBasicUpdateNonSeparatorTokens",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				request: &[]string{"@", "#"},
+			},
+			wantTask: &Task{
+				UID: 1,
+			},
+		},
+		{
+			name: "TestIndexUpdateNonSeparatorTokensWithCustomClient",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				request: &[]string{"@", "#"},
+			},
+			wantTask: &Task{
+				UID: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotTask, err := i.UpdateNonSeparatorTokens(tt.args.request)
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.UID, tt.wantTask.UID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetNonSeparatorTokens()
+			require.NoError(t, err)
+			require.Equal(t, tt.args.request, gotResp)
+		})
+	}
+}
+
+func TestIndex_ResetNonSeparatorTokens(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request *[]string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *Task
+		wantResp *[]string
+	}{
+		{
+			name: "TestIndexBasicResetNonSeparatorTokens",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				request: &[]string{"@", "#"},
+			},
+			wantTask: &Task{
+				UID: 1,
+			},
+			wantResp: &[]string{},
+		},
+		{
+			name: "TestIndexResetNonSeparatorTokensWithCustomClient",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				request: &[]string{"@", "#"},
+			},
+			wantTask: &Task{
+				UID: 1,
+			},
+			wantResp: &[]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotTask, err := i.UpdateNonSeparatorTokens(tt.args.request)
+			require.NoError(t, err)
+			testWaitForTask(t, i, gotTask)
+
+			gotTask, err = i.ResetNonSeparatorTokens()
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.UID, tt.wantTask.UID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetNonSeparatorTokens()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, gotResp)
+		})
+	}
+}
+
+func TestIndex_GetDictionary(t *testing.T) {
+	type args struct {
+		UID    string
+		client *Client
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantResp *[]string
+	}{
+		{
+			name: "TestIndexBasicGetDictionary",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+			},
+			wantResp: &[]string{},
+		},
+		{
+			name: "TestIndexGetDictionaryWithCustomClient",
+			args: args{
+				UID:    "indexUID",
+				client: customClient,
+			},
+			wantResp: &[]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotResp, err := i.GetDictionary()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, gotResp)
+		})
+	}
+}
+
+func TestIndex_UpdateDictionary(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request *[]string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *Task
+	}{
+		{
+			name: "TestIndexBasicUpdateDictionary",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				request: &[]string{"J. R. R.", "W. E. B."},
+			},
+			wantTask: &Task{
+				UID: 1,
+			},
+		},
+		{
+			name: "TestIndexUpdateDictionaryWithCustomClient",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				request: &[]string{"J. R. R.", "W. E. B."},
+			},
+			wantTask: &Task{
+				UID: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotTask, err := i.UpdateDictionary(tt.args.request)
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.UID, tt.wantTask.UID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetDictionary()
+			require.NoError(t, err)
+			require.Equal(t, tt.args.request, gotResp)
+		})
+	}
+}
+
+func TestIndex_ResetDictionary(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request *[]string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *Task
+		wantResp *[]string
+	}{
+		{
+			name: "TestIndexBasicResetDictionary",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				request: &[]string{"J. R. R.", "W. E. B."},
+			},
+			wantTask: &Task{
+				UID: 1,
+			},
+			wantResp: &[]string{},
+		},
+		{
+			name: "TestIndexResetDictionaryWithCustomClient",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				request: &[]string{"J. R. R.", "W. E. B."},
+			},
+			wantTask: &Task{
+				UID: 1,
+			},
+			wantResp: &[]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotTask, err := i.UpdateDictionary(tt.args.request)
+			require.NoError(t, err)
+			testWaitForTask(t, i, gotTask)
+
+			gotTask, err = i.ResetDictionary()
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.UID, tt.wantTask.UID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetDictionary()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, gotResp)
+		})
+	}
+}
+
+func TestIndex_SettingsUnsupported(t *testing.T) {
+	// No route is known below an unknown path prefix, like the routes of the
+	// typo tolerance, faceting, pagination, tokens and dictionary settings by
+	// servers older than v0.28 or v1.3
+	old := NewClient(ClientConfig{
+		Host:   "http://localhost:7700/unknown",
+		APIKey: masterKey,
+	})
+	i := old.Index("indexUID")
+
+	_, err := i.UpdateFaceting(&Faceting{MaxValuesPerFacet: 200})
+	require.ErrorIs(t, err, ErrUnsupportedByServer)
+	require.Contains(t, err.Error(), "UpdateFaceting is unsupported by this server, it requires Meilisearch v0.28 or later")
+
+	_, err = i.GetDictionary()
+	require.ErrorIs(t, err, ErrUnsupportedByServer)
+	require.Contains(t, err.Error(), "GetDictionary is unsupported by this server, it requires Meilisearch v1.3 or later")
+}
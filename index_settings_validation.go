@@ -0,0 +1,138 @@
+package meilisearch
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ValidateSettings checks settings before they are sent to Meilisearch:
+// ranking rules must be built-in rules or "attribute:asc" and
+// "attribute:desc" custom rules, attribute names and stop words must not be
+// empty, "*" must be the only searchable or displayed attribute if present,
+// lists must not hold duplicates, synonyms, tokens and dictionary words must
+// not be empty, the minimum word size for one typo must not exceed the one
+// for two typos and limits must not be negative.
+// A *SettingsValidationError listing every problem is returned.
+func ValidateSettings(settings *Settings) error {
+	v := &settingsValidator{}
+
+	v.checkRankingRules("rankingRules", settings.RankingRules)
+	if settings.DistinctAttribute != nil && strings.TrimSpace(*settings.DistinctAttribute) != *settings.DistinctAttribute {
+		v.addf("distinctAttribute: %q has surrounding spaces", *settings.DistinctAttribute)
+	}
+	v.checkAttributes("searchableAttributes", settings.SearchableAttributes, true)
+	v.checkAttributes("displayedAttributes", settings.DisplayedAttributes, true)
+	v.checkAttributes("filterableAttributes", settings.FilterableAttributes, false)
+	v.checkAttributes("sortableAttributes", settings.SortableAttributes, false)
+	for j, word := range settings.StopWords {
+		if word == "" {
+			v.addf("stopWords[%d]: empty stop word", j)
+		}
+	}
+
+	words := make([]string, 0, len(settings.Synonyms))
+	for word := range settings.Synonyms {
+		words = append(words, word)
+	}
+	sort.Strings(words)
+	for _, word := range words {
+		if word == "" {
+			v.addf("synonyms: empty word")
+			continue
+		}
+		if len(settings.Synonyms[word]) == 0 {
+			v.addf("synonyms[%q]: no synonym", word)
+		}
+		for j, synonym := range settings.Synonyms[word] {
+			if synonym == "" {
+				v.addf("synonyms[%q][%d]: empty synonym", word, j)
+			}
+		}
+	}
+
+	if typoTolerance := settings.TypoTolerance; typoTolerance != nil {
+		if sizes := typoTolerance.MinWordSizeForTypos; sizes != nil {
+			if sizes.OneTypo < 0 || sizes.TwoTypos < 0 {
+				v.addf("typoTolerance.minWordSizeForTypos: negative size")
+			} else if sizes.OneTypo != 0 && sizes.TwoTypos != 0 && sizes.OneTypo > sizes.TwoTypos {
+				v.addf("typoTolerance.minWordSizeForTypos: oneTypo (%d) is greater than twoTypos (%d)", sizes.OneTypo, sizes.TwoTypos)
+			}
+		}
+		for j, word := range typoTolerance.DisableOnWords {
+			if word == "" {
+				v.addf("typoTolerance.disableOnWords[%d]: empty word", j)
+			}
+		}
+		v.checkAttributes("typoTolerance.disableOnAttributes", typoTolerance.DisableOnAttributes, false)
+	}
+	if settings.Faceting != nil && settings.Faceting.MaxValuesPerFacet < 0 {
+		v.addf("faceting.maxValuesPerFacet: %d is negative", settings.Faceting.MaxValuesPerFacet)
+	}
+	if settings.Pagination != nil && settings.Pagination.MaxTotalHits < 0 {
+		v.addf("pagination.maxTotalHits: %d is negative", settings.Pagination.MaxTotalHits)
+	}
+	v.checkTokens("separatorTokens", settings.SeparatorTokens)
+	v.checkTokens("nonSeparatorTokens", settings.NonSeparatorTokens)
+	v.checkTokens("dictionary", settings.Dictionary)
+
+	if v.problems != nil {
+		return &SettingsValidationError{Problems: v.problems}
+	}
+	return nil
+}
+
+type settingsValidator struct {
+	problems []string
+}
+
+func (v *settingsValidator) addf(format string, args ...interface{}) {
+	v.problems = append(v.problems, fmt.Sprintf(format, args...))
+}
+
+// checkAttributes checks the attribute names of a setting, allowWildcard
+// accepting "*" as the only attribute
+func (v *settingsValidator) checkAttributes(setting string, attributes []string, allowWildcard bool) {
+	v.checkDuplicates(setting, attributes)
+	for j, attribute := range attributes {
+		switch {
+		case attribute == "":
+			v.addf("%s[%d]: empty attribute", setting, j)
+		case attribute == "*" && !allowWildcard:
+			v.addf("%s[%d]: \"*\" is not allowed", setting, j)
+		case attribute == "*" && len(attributes) > 1:
+			v.addf("%s[%d]: \"*\" must be the only attribute", setting, j)
+		case strings.TrimSpace(attribute) != attribute:
+			v.addf("%s[%d]: %q has surrounding spaces", setting, j, attribute)
+		}
+	}
+}
+
+// checkRankingRules checks that the ranking rules of a setting are valid
+// RankingRules and are not duplicated
+func (v *settingsValidator) checkRankingRules(setting string, rules []string) {
+	v.checkDuplicates(setting, rules)
+	for j, rule := range rules {
+		if err := RankingRule(rule).Validate(); err != nil {
+			v.addf("%s[%d]: %v", setting, j, err)
+		}
+	}
+}
+
+func (v *settingsValidator) checkTokens(setting string, tokens []string) {
+	for j, token := range tokens {
+		if token == "" {
+			v.addf("%s[%d]: empty token", setting, j)
+		}
+	}
+}
+
+func (v *settingsValidator) checkDuplicates(setting string, list []string) {
+	seen := make(map[string]bool, len(list))
+	for j, element := range list {
+		if seen[element] {
+			v.addf("%s[%d]: duplicate %q", setting, j, element)
+		}
+		seen[element] = true
+	}
+}
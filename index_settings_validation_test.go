@@ -0,0 +1,119 @@
+package meilisearch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSettings(t *testing.T) {
+	distinct := " isbn"
+	tests := []struct {
+		name         string
+		settings     *Settings
+		wantProblems []string
+	}{
+		{
+			name: "TestValidateSettingsValid",
+			settings: &Settings{
+				RankingRules:         []string{"words", "typo", "release_date:desc", "author.rank:asc"},
+				SearchableAttributes: []string{"*"},
+				DisplayedAttributes:  []string{"title", "author.name"},
+				FilterableAttributes: []string{"genre"},
+				StopWords:            []string{"the"},
+				Synonyms:             map[string][]string{"tale": {"story"}},
+			},
+		},
+		{
+			name:     "TestValidateSettingsEmpty",
+			settings: &Settings{},
+		},
+		{
+			name: "TestValidateSettingsRankingRules",
+			settings: &Settings{
+				RankingRules: []string{"words", "speed", ":asc", "year:up", "words"},
+			},
+			wantProblems: []string{
+				`rankingRules[4]: duplicate "words"`,
+				`rankingRules[1]: unknown ranking rule "speed"`,
+				`rankingRules[2]: unknown ranking rule ":asc"`,
+				`rankingRules[3]: unknown ranking rule "year:up"`,
+			},
+		},
+		{
+			name: "TestValidateSettingsAttributes",
+			settings: &Settings{
+				DistinctAttribute:    &distinct,
+				SearchableAttributes: []string{"title", "*"},
+				DisplayedAttributes:  []string{"title", "", "title"},
+				FilterableAttributes: []string{"*"},
+				SortableAttributes:   []string{"year "},
+			},
+			wantProblems: []string{
+				`distinctAttribute: " isbn" has surrounding spaces`,
+				`searchableAttributes[1]: "*" must be the only attribute`,
+				`displayedAttributes[2]: duplicate "title"`,
+				`displayedAttributes[1]: empty attribute`,
+				`filterableAttributes[0]: "*" is not allowed`,
+				`sortableAttributes[0]: "year " has surrounding spaces`,
+			},
+		},
+		{
+			name: "TestValidateSettingsWords",
+			settings: &Settings{
+				StopWords: []string{"the", ""},
+				Synonyms:  map[string][]string{"": {"a"}, "tale": {}, "story": {"tale", ""}},
+			},
+			wantProblems: []string{
+				`stopWords[1]: empty stop word`,
+				`synonyms: empty word`,
+				`synonyms["story"][1]: empty synonym`,
+				`synonyms["tale"]: no synonym`,
+			},
+		},
+		{
+			name: "TestValidateSettingsTypoTolerance",
+			settings: &Settings{
+				TypoTolerance: &TypoTolerance{
+					MinWordSizeForTypos: &MinWordSizeForTypos{OneTypo: 6, TwoTypos: 4},
+					DisableOnWords:      []string{""},
+					DisableOnAttributes: []string{"title", "title"},
+				},
+			},
+			wantProblems: []string{
+				`typoTolerance.minWordSizeForTypos: oneTypo (6) is greater than twoTypos (4)`,
+				`typoTolerance.disableOnWords[0]: empty word`,
+				`typoTolerance.disableOnAttributes[1]: duplicate "title"`,
+			},
+		},
+		{
+			name: "TestValidateSettingsLimitsAndTokens",
+			settings: &Settings{
+				TypoTolerance:      &TypoTolerance{MinWordSizeForTypos: &MinWordSizeForTypos{OneTypo: -1}},
+				Faceting:           &Faceting{MaxValuesPerFacet: -1},
+				Pagination:         &Pagination{MaxTotalHits: -10},
+				SeparatorTokens:    []string{"|", ""},
+				NonSeparatorTokens: []string{""},
+				Dictionary:         []string{"J. K.", ""},
+			},
+			wantProblems: []string{
+				`typoTolerance.minWordSizeForTypos: negative size`,
+				`faceting.maxValuesPerFacet: -1 is negative`,
+				`pagination.maxTotalHits: -10 is negative`,
+				`separatorTokens[1]: empty token`,
+				`nonSeparatorTokens[0]: empty token`,
+				`dictionary[1]: empty token`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSettings(tt.settings)
+			if tt.wantProblems == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.Equal(t, &SettingsValidationError{Problems: tt.wantProblems}, err)
+		})
+	}
+}
@@ -166,9 +166,6 @@ var (
 		Host:   "http://localhost:7700",
 		APIKey: masterKey,
 	})
-	defaultRankingRules = []string{
-		"words", "typo", "proximity", "attribute", "sort", "exactness",
-	}
 )
 
 var customClient = NewFastHTTPCustomClient(ClientConfig{
@@ -0,0 +1,365 @@
+// Package parquet adds the rows of Parquet files to a Meilisearch index.
+//
+// Rows become documents with one field per column. Groups become JSON
+// objects, repeated fields, LIST and MAP groups become JSON arrays and
+// objects. Decimals are kept as exact JSON numbers, dates and timestamps are
+// encoded in RFC 3339 format and byte arrays as strings.
+package parquet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/meilisearch/meilisearch-go"
+	"github.com/pkg/errors"
+	pq "github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/schema"
+	"github.com/xitongsys/parquet-go/source"
+	"github.com/xitongsys/parquet-go/types"
+)
+
+// AddDocumentsFromFile adds the rows of the Parquet file at path to index,
+// batchSize rows at a time, like AddDocumentsFromReaderAt.
+func AddDocumentsFromFile(ctx context.Context, index *meilisearch.Index, path string, batchSize int, primaryKey ...string) (resp []meilisearch.Task, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open Parquet file")
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open Parquet file")
+	}
+	return AddDocumentsFromReaderAt(ctx, index, f, info.Size(), batchSize, primaryKey...)
+}
+
+// AddDocumentsFromReaderAt adds the rows of the Parquet file of size bytes
+// read from r to index, batchSize rows at a time.
+// Rows are read and sent continuously to avoid reading the whole file into
+// memory, and the next batch is not read once ctx is done. Like
+// Index.AddDocumentsInBatches, a *meilisearch.BatchError holding the tasks
+// already enqueued is returned if a batch fails.
+func AddDocumentsFromReaderAt(ctx context.Context, index *meilisearch.Index, r io.ReaderAt, size int64, batchSize int, primaryKey ...string) (resp []meilisearch.Task, err error) {
+	if batchSize <= 0 {
+		return nil, errors.Errorf("invalid batch size %d, must be positive", batchSize)
+	}
+	pr, err := reader.NewParquetReader(newReaderAtFile(r, size), nil, 1)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read Parquet file")
+	}
+	defer pr.ReadStop()
+	root := newNode(pr.SchemaHandler)
+
+	numRows := int(pr.GetNumRows())
+	for start := 0; start < numRows; start += batchSize {
+		end := start + batchSize
+		if end > numRows {
+			end = numRows
+		}
+
+		batchError := func(err error) error {
+			return &meilisearch.BatchError{
+				Tasks:       resp,
+				BatchIndex:  len(resp),
+				Start:       start,
+				End:         end,
+				StartOffset: -1,
+				EndOffset:   -1,
+				OriginError: err,
+			}
+		}
+
+		if err := ctx.Err(); err != nil {
+			return nil, batchError(err)
+		}
+		rows, err := pr.ReadByNumber(end - start)
+		if err != nil {
+			return nil, batchError(errors.Wrap(err, "could not read Parquet rows"))
+		}
+		documents := make([]interface{}, len(rows))
+		for j, row := range rows {
+			documents[j] = root.convert(reflect.ValueOf(row))
+		}
+
+		task, err := index.AddDocuments(documents, primaryKey...)
+		if err != nil {
+			return nil, batchError(err)
+		}
+		resp = append(resp, *task)
+	}
+
+	return resp, nil
+}
+
+// node is an element of the schema of a Parquet file. Rows are read by
+// parquet-go as values of types derived from the schema, with fields named
+// after the internal names of the columns.
+type node struct {
+	inName   string
+	exName   string
+	element  *pq.SchemaElement
+	children []*node
+}
+
+func newNode(sh *schema.SchemaHandler) *node {
+	pos := 0
+	return buildNode(sh, &pos)
+}
+
+func buildNode(sh *schema.SchemaHandler, pos *int) *node {
+	n := &node{
+		inName:  sh.Infos[*pos].InName,
+		exName:  sh.Infos[*pos].ExName,
+		element: sh.SchemaElements[*pos],
+	}
+	numChildren := int(n.element.GetNumChildren())
+	*pos++
+	for j := 0; j < numChildren; j++ {
+		n.children = append(n.children, buildNode(sh, pos))
+	}
+	return n
+}
+
+func (n *node) repetition() pq.FieldRepetitionType {
+	if n.element.RepetitionType == nil {
+		return pq.FieldRepetitionType_REQUIRED
+	}
+	return *n.element.RepetitionType
+}
+
+func (n *node) convertedType(convertedType pq.ConvertedType) bool {
+	return n.element.ConvertedType != nil && *n.element.ConvertedType == convertedType
+}
+
+// listElement returns the element node of a LIST group following the naming
+// parquet-go reads as a slice
+func (n *node) listElement() *node {
+	if n.convertedType(pq.ConvertedType_LIST) && len(n.children) == 1 && n.children[0].inName == "List" &&
+		len(n.children[0].children) == 1 && n.children[0].children[0].inName == "Element" {
+		return n.children[0].children[0]
+	}
+	return nil
+}
+
+// mapKeyValue returns the key and value nodes of a MAP group following the
+// naming parquet-go reads as a map
+func (n *node) mapKeyValue() (*node, *node) {
+	if n.convertedType(pq.ConvertedType_MAP) && len(n.children) == 1 && n.children[0].inName == "Key_value" &&
+		len(n.children[0].children) == 2 && n.children[0].children[0].inName == "Key" &&
+		n.children[0].children[1].inName == "Value" {
+		return n.children[0].children[0], n.children[0].children[1]
+	}
+	return nil, nil
+}
+
+// convert turns v, read by parquet-go for n, into a value encoded as expected
+// in a document
+func (n *node) convert(v reflect.Value) interface{} {
+	if len(n.children) == 0 {
+		if n.repetition() == pq.FieldRepetitionType_REPEATED {
+			return convertSlice(v, n.convertLeaf)
+		}
+		return n.convertLeaf(v)
+	}
+
+	if element := n.listElement(); element != nil {
+		return convertSlice(v, element.convert)
+	}
+	if key, value := n.mapKeyValue(); key != nil {
+		if v.IsNil() {
+			return nil
+		}
+		object := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			object[fmt.Sprint(key.convert(iter.Key()))] = value.convert(iter.Value())
+		}
+		return object
+	}
+
+	switch n.repetition() {
+	case pq.FieldRepetitionType_REPEATED:
+		return convertSlice(v, n.convertGroup)
+	case pq.FieldRepetitionType_OPTIONAL:
+		if v.IsNil() {
+			return nil
+		}
+		return n.convertGroup(v.Elem())
+	default:
+		return n.convertGroup(v)
+	}
+}
+
+// convertGroup converts a struct read for a group
+func (n *node) convertGroup(v reflect.Value) interface{} {
+	object := make(map[string]interface{}, len(n.children))
+	for _, child := range n.children {
+		object[child.exName] = child.convert(v.FieldByName(child.inName))
+	}
+
+	// LIST and MAP groups not following the standard naming are read as
+	// groups holding a repeated group, e.g. {"list": [{"item": 1}]}
+	if len(n.children) == 1 && n.children[0].repetition() == pq.FieldRepetitionType_REPEATED {
+		entries, _ := object[n.children[0].exName].([]interface{})
+		switch {
+		case n.convertedType(pq.ConvertedType_LIST):
+			if len(n.children[0].children) == 0 {
+				return entries
+			}
+			if len(n.children[0].children) == 1 {
+				values := make([]interface{}, len(entries))
+				for j, entry := range entries {
+					values[j] = entry.(map[string]interface{})[n.children[0].children[0].exName]
+				}
+				return values
+			}
+		case n.convertedType(pq.ConvertedType_MAP) && len(n.children[0].children) == 2:
+			key, value := n.children[0].children[0].exName, n.children[0].children[1].exName
+			values := make(map[string]interface{}, len(entries))
+			for _, entry := range entries {
+				e := entry.(map[string]interface{})
+				values[fmt.Sprint(e[key])] = e[value]
+			}
+			return values
+		}
+	}
+	return object
+}
+
+func convertSlice(v reflect.Value, convert func(reflect.Value) interface{}) interface{} {
+	if v.IsNil() {
+		return nil
+	}
+	values := make([]interface{}, v.Len())
+	for j := range values {
+		values[j] = convert(v.Index(j))
+	}
+	return values
+}
+
+// convertLeaf converts a primitive value according to the logical type of
+// its column
+func (n *node) convertLeaf(v reflect.Value) interface{} {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	value := v.Interface()
+	e := n.element
+
+	switch {
+	case n.convertedType(pq.ConvertedType_DECIMAL) || (e.LogicalType != nil && e.LogicalType.DECIMAL != nil):
+		return convertDecimal(value, int(e.GetScale()))
+	case n.convertedType(pq.ConvertedType_DATE) || (e.LogicalType != nil && e.LogicalType.DATE != nil):
+		if days, ok := value.(int32); ok {
+			return time.Unix(int64(days)*24*60*60, 0).UTC().Format("2006-01-02")
+		}
+	case n.convertedType(pq.ConvertedType_TIMESTAMP_MILLIS):
+		if ms, ok := value.(int64); ok {
+			return time.Unix(0, ms*int64(time.Millisecond)).UTC()
+		}
+	case n.convertedType(pq.ConvertedType_TIMESTAMP_MICROS):
+		if us, ok := value.(int64); ok {
+			return time.Unix(0, us*int64(time.Microsecond)).UTC()
+		}
+	case e.LogicalType != nil && e.LogicalType.TIMESTAMP != nil && e.LogicalType.TIMESTAMP.Unit != nil:
+		if t, ok := value.(int64); ok {
+			unit := e.LogicalType.TIMESTAMP.Unit
+			switch {
+			case unit.MILLIS != nil:
+				return time.Unix(0, t*int64(time.Millisecond)).UTC()
+			case unit.MICROS != nil:
+				return time.Unix(0, t*int64(time.Microsecond)).UTC()
+			case unit.NANOS != nil:
+				return time.Unix(0, t).UTC()
+			}
+		}
+	case e.Type != nil && *e.Type == pq.Type_INT96:
+		if s, ok := value.(string); ok && len(s) == 12 {
+			return types.INT96ToTime(s).UTC()
+		}
+	case n.convertedType(pq.ConvertedType_JSON) || (e.LogicalType != nil && e.LogicalType.JSON != nil):
+		if s, ok := value.(string); ok && json.Valid([]byte(s)) {
+			return json.RawMessage(s)
+		}
+	}
+	return value
+}
+
+// convertDecimal converts the unscaled value of a decimal into an exact JSON
+// number
+func convertDecimal(value interface{}, scale int) interface{} {
+	unscaled := new(big.Int)
+	switch v := value.(type) {
+	case int32:
+		unscaled.SetInt64(int64(v))
+	case int64:
+		unscaled.SetInt64(v)
+	case string:
+		// Big-endian two's complement
+		unscaled.SetBytes([]byte(v))
+		if len(v) > 0 && v[0]&0x80 != 0 {
+			unscaled.Sub(unscaled, new(big.Int).Lsh(big.NewInt(1), uint(len(v)*8)))
+		}
+	default:
+		return value
+	}
+
+	digits := unscaled.String()
+	if scale <= 0 {
+		return json.Number(digits)
+	}
+	sign := ""
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	return json.Number(sign + digits[:len(digits)-scale] + "." + digits[len(digits)-scale:])
+}
+
+// readerAtFile is a source.ParquetFile reading a Parquet file from an
+// io.ReaderAt. parquet-go opens the file again for each column it reads, so
+// each opened file gets its own position.
+type readerAtFile struct {
+	*io.SectionReader
+	r    io.ReaderAt
+	size int64
+}
+
+var _ source.ParquetFile = &readerAtFile{}
+
+func newReaderAtFile(r io.ReaderAt, size int64) *readerAtFile {
+	return &readerAtFile{
+		SectionReader: io.NewSectionReader(r, 0, size),
+		r:             r,
+		size:          size,
+	}
+}
+
+func (f *readerAtFile) Open(string) (source.ParquetFile, error) {
+	return newReaderAtFile(f.r, f.size), nil
+}
+
+func (f *readerAtFile) Create(string) (source.ParquetFile, error) {
+	return nil, errors.New("Parquet file is read only")
+}
+
+func (f *readerAtFile) Write([]byte) (int, error) {
+	return 0, errors.New("Parquet file is read only")
+}
+
+func (f *readerAtFile) Close() error {
+	return nil
+}
@@ -0,0 +1,177 @@
+package parquet
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/meilisearch/meilisearch-go"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+type testAuthor struct {
+	Name    string `parquet:"name=name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Country string `parquet:"name=country, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+type testBook struct {
+	ID          int64            `parquet:"name=id, type=INT64"`
+	Title       string           `parquet:"name=title, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Subtitle    *string          `parquet:"name=subtitle, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Price       int64            `parquet:"name=price, type=INT64, convertedtype=DECIMAL, scale=2, precision=10"`
+	PublishedAt *int32           `parquet:"name=published_at, type=INT32, convertedtype=DATE"`
+	Tags        []string         `parquet:"name=tags, type=LIST, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8"`
+	Ratings     map[string]int32 `parquet:"name=ratings, type=MAP, convertedtype=MAP, keytype=BYTE_ARRAY, keyconvertedtype=UTF8, valuetype=INT32"`
+	Author      *testAuthor      `parquet:"name=author"`
+}
+
+func testParquetFile(t *testing.T) []byte {
+	subtitle := "Through the Looking-Glass"
+	// 1865-11-26
+	publishedAt := int32(-38021)
+
+	b := new(bytes.Buffer)
+	w, err := writer.NewParquetWriterFromWriter(b, new(testBook), 1)
+	require.NoError(t, err)
+	for _, book := range []testBook{
+		{
+			ID:          1,
+			Title:       "Alice In Wonderland",
+			Subtitle:    &subtitle,
+			Price:       1250,
+			PublishedAt: &publishedAt,
+			Tags:        []string{"tale", "classic"},
+			Ratings:     map[string]int32{"goodreads": 4},
+			Author:      &testAuthor{Name: "Lewis Carroll", Country: "UK"},
+		},
+		{ID: 2, Title: "Le Petit Prince", Price: 800, Tags: []string{}, Ratings: map[string]int32{}},
+		{ID: 3, Title: "Pride and Prejudice", Price: -5, Tags: []string{"romance"}, Ratings: map[string]int32{}},
+	} {
+		require.NoError(t, w.Write(book))
+	}
+	require.NoError(t, w.WriteStop())
+	return b.Bytes()
+}
+
+var testWantDocuments = []map[string]interface{}{
+	{
+		"id":           float64(1),
+		"title":        "Alice In Wonderland",
+		"subtitle":     "Through the Looking-Glass",
+		"price":        12.5,
+		"published_at": "1865-11-26",
+		"tags":         []interface{}{"tale", "classic"},
+		"ratings":      map[string]interface{}{"goodreads": float64(4)},
+		"author":       map[string]interface{}{"name": "Lewis Carroll", "country": "UK"},
+	},
+	{
+		"id":           float64(2),
+		"title":        "Le Petit Prince",
+		"subtitle":     nil,
+		"price":        float64(8),
+		"published_at": nil,
+		"tags":         []interface{}{},
+		"ratings":      map[string]interface{}{},
+		"author":       nil,
+	},
+	{
+		"id":           float64(3),
+		"title":        "Pride and Prejudice",
+		"subtitle":     nil,
+		"price":        -0.05,
+		"published_at": nil,
+		"tags":         []interface{}{"romance"},
+		"ratings":      map[string]interface{}{},
+		"author":       nil,
+	},
+}
+
+func Test_convertDecimal(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		scale int
+		want  interface{}
+	}{
+		{name: "Int32", value: int32(1250), scale: 2, want: json.Number("12.50")},
+		{name: "Int64Small", value: int64(5), scale: 3, want: json.Number("0.005")},
+		{name: "Negative", value: int64(-5), scale: 2, want: json.Number("-0.05")},
+		{name: "NoScale", value: int64(42), scale: 0, want: json.Number("42")},
+		{name: "Bytes", value: string([]byte{0x04, 0xe2}), scale: 2, want: json.Number("12.50")},
+		{name: "NegativeBytes", value: string([]byte{0xfb, 0x1e}), scale: 2, want: json.Number("-12.50")},
+		{name: "Unsupported", value: 1.5, scale: 2, want: 1.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, convertDecimal(tt.value, tt.scale))
+		})
+	}
+}
+
+func TestAddDocumentsFromFile(t *testing.T) {
+	client := meilisearch.NewClient(meilisearch.ClientConfig{
+		Host:   "http://localhost:7700",
+		APIKey: "masterKey",
+	})
+	index := client.Index("TestParquetAddDocumentsFromFile")
+	t.Cleanup(func() {
+		task, _ := client.DeleteIndex(index.UID)
+		if task != nil {
+			_, _ = client.WaitForTask(task)
+		}
+	})
+
+	path := filepath.Join(t.TempDir(), "books.parquet")
+	require.NoError(t, os.WriteFile(path, testParquetFile(t), 0600))
+
+	gotResp, err := AddDocumentsFromFile(context.Background(), index, path, 2, "id")
+	require.NoError(t, err)
+	require.Len(t, gotResp, 2)
+	for _, task := range gotResp {
+		finalTask, err := index.WaitForTask(&task)
+		require.NoError(t, err)
+		require.Equal(t, meilisearch.TaskStatusSucceeded, finalTask.Status)
+	}
+
+	var documents []map[string]interface{}
+	err = index.GetDocuments(&meilisearch.DocumentsRequest{}, &documents)
+	require.NoError(t, err)
+	require.Equal(t, testWantDocuments, documents)
+}
+
+func TestAddDocumentsFromReaderAtCanceled(t *testing.T) {
+	client := meilisearch.NewClient(meilisearch.ClientConfig{
+		Host:   "http://localhost:7700",
+		APIKey: "masterKey",
+	})
+	index := client.Index("TestParquetAddDocumentsFromReaderAtCanceled")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	data := testParquetFile(t)
+	_, err := AddDocumentsFromReaderAt(ctx, index, bytes.NewReader(data), int64(len(data)), 2, "id")
+	var batchErr *meilisearch.BatchError
+	require.ErrorAs(t, err, &batchErr)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 0, batchErr.Start)
+	require.Equal(t, 2, batchErr.End)
+}
+
+func TestAddDocumentsFromReaderAtInvalidBatchSize(t *testing.T) {
+	client := meilisearch.NewClient(meilisearch.ClientConfig{
+		Host:   "http://localhost:7700",
+		APIKey: "masterKey",
+	})
+	index := client.Index("TestParquetAddDocumentsFromReaderAtInvalidBatchSize")
+
+	data := testParquetFile(t)
+	for _, batchSize := range []int{0, -1} {
+		_, err := AddDocumentsFromReaderAt(context.Background(), index, bytes.NewReader(data), int64(len(data)), batchSize, "id")
+		require.EqualError(t, err, fmt.Sprintf("invalid batch size %d, must be positive", batchSize))
+	}
+}
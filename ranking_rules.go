@@ -0,0 +1,177 @@
+package meilisearch
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// RankingRule is a ranking rule of an index: one of the built-in rules or a
+// custom rule sorting documents on an attribute, made with Asc or Desc.
+type RankingRule string
+
+const (
+	// RankingRuleWords sorts documents by the number of query words they match
+	RankingRuleWords RankingRule = "words"
+	// RankingRuleTypo sorts documents by the number of typos of their matches
+	RankingRuleTypo RankingRule = "typo"
+	// RankingRuleProximity sorts documents by the distance between their
+	// matched query words
+	RankingRuleProximity RankingRule = "proximity"
+	// RankingRuleAttribute sorts documents by the importance of the attributes
+	// they match in, given by the order of the searchable attributes
+	RankingRuleAttribute RankingRule = "attribute"
+	// RankingRuleSort sorts documents by the sort parameter of the search
+	RankingRuleSort RankingRule = "sort"
+	// RankingRuleExactness sorts documents by the similarity of their matched
+	// words with the query words
+	RankingRuleExactness RankingRule = "exactness"
+)
+
+// builtinRankingRules are the ranking rules not bound to an attribute
+var builtinRankingRules = map[RankingRule]bool{
+	RankingRuleWords:     true,
+	RankingRuleTypo:      true,
+	RankingRuleProximity: true,
+	RankingRuleAttribute: true,
+	RankingRuleSort:      true,
+	RankingRuleExactness: true,
+}
+
+// DefaultRankingRules returns the ranking rules of an index whose ranking
+// rules were never updated
+func DefaultRankingRules() []RankingRule {
+	return []RankingRule{
+		RankingRuleWords,
+		RankingRuleTypo,
+		RankingRuleProximity,
+		RankingRuleAttribute,
+		RankingRuleSort,
+		RankingRuleExactness,
+	}
+}
+
+// Asc returns the custom ranking rule sorting documents by ascending values of
+// attribute ("attribute:asc")
+func Asc(attribute string) RankingRule {
+	return RankingRule(attribute + ":asc")
+}
+
+// Desc returns the custom ranking rule sorting documents by descending values
+// of attribute ("attribute:desc")
+func Desc(attribute string) RankingRule {
+	return RankingRule(attribute + ":desc")
+}
+
+// IsBuiltin reports whether r is one of the built-in ranking rules
+func (r RankingRule) IsBuiltin() bool {
+	return builtinRankingRules[r]
+}
+
+// Attribute returns the attribute a custom ranking rule sorts on and whether
+// it sorts in descending order. ok is false if r is not a custom rule.
+func (r RankingRule) Attribute() (attribute string, descending bool, ok bool) {
+	rule := string(r)
+	separator := strings.LastIndex(rule, ":")
+	if separator <= 0 {
+		return "", false, false
+	}
+	switch rule[separator+1:] {
+	case "asc":
+		return rule[:separator], false, true
+	case "desc":
+		return rule[:separator], true, true
+	default:
+		return "", false, false
+	}
+}
+
+// Validate returns an error if r is neither a built-in ranking rule nor a
+// custom rule made with Asc or Desc.
+func (r RankingRule) Validate() error {
+	if r.IsBuiltin() {
+		return nil
+	}
+	if _, _, ok := r.Attribute(); ok {
+		return nil
+	}
+	return errors.Errorf("unknown ranking rule %q", string(r))
+}
+
+// String returns the ranking rule as sent to Meilisearch
+func (r RankingRule) String() string {
+	return string(r)
+}
+
+// ParseRankingRule parses a ranking rule as returned by GetRankingRules,
+// returning an error if it is not valid.
+func ParseRankingRule(rule string) (RankingRule, error) {
+	rankingRule := RankingRule(rule)
+	if err := rankingRule.Validate(); err != nil {
+		return "", err
+	}
+	return rankingRule, nil
+}
+
+// ParseRankingRules parses the ranking rules returned by GetRankingRules. A
+// *SettingsValidationError listing the invalid and duplicate rules is
+// returned.
+func ParseRankingRules(rules []string) ([]RankingRule, error) {
+	rankingRules := make([]RankingRule, 0, len(rules))
+	for _, rule := range rules {
+		rankingRules = append(rankingRules, RankingRule(rule))
+	}
+	if err := ValidateRankingRules(rankingRules); err != nil {
+		return nil, err
+	}
+	return rankingRules, nil
+}
+
+// FormatRankingRules returns the ranking rules as expected by
+// UpdateRankingRules, ParseRankingRules parsing them back.
+func FormatRankingRules(rules []RankingRule) []string {
+	formatted := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		formatted = append(formatted, rule.String())
+	}
+	return formatted
+}
+
+// ValidateRankingRules checks that every ranking rule is valid and that no
+// rule is given twice. A *SettingsValidationError listing every problem is
+// returned.
+func ValidateRankingRules(rules []RankingRule) error {
+	v := &settingsValidator{}
+	v.checkRankingRules("rankingRules", FormatRankingRules(rules))
+	if v.problems != nil {
+		return &SettingsValidationError{Problems: v.problems}
+	}
+	return nil
+}
+
+// GetTypedRankingRules returns the ranking rules of the index, see
+// GetRankingRules.
+func (i Index) GetTypedRankingRules() ([]RankingRule, error) {
+	rules, err := i.GetRankingRules()
+	if err != nil {
+		return nil, err
+	}
+	return ParseRankingRules(*rules)
+}
+
+// UpdateTypedRankingRules validates the ranking rules with
+// ValidateRankingRules and updates the ranking rules of the index with them,
+// nothing is sent to Meilisearch if they are not valid:
+//
+//	task, err := index.UpdateTypedRankingRules([]meilisearch.RankingRule{
+//		meilisearch.RankingRuleWords,
+//		meilisearch.RankingRuleTypo,
+//		meilisearch.Desc("release_date"),
+//	})
+func (i Index) UpdateTypedRankingRules(rules []RankingRule) (*Task, error) {
+	if err := ValidateRankingRules(rules); err != nil {
+		return nil, err
+	}
+	request := FormatRankingRules(rules)
+	return i.UpdateRankingRules(&request)
+}
@@ -0,0 +1,142 @@
+package meilisearch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankingRule(t *testing.T) {
+	tests := []struct {
+		name           string
+		rule           RankingRule
+		wantBuiltin    bool
+		wantAttribute  string
+		wantDescending bool
+		wantCustom     bool
+		wantErr        string
+	}{
+		{
+			name:        "TestRankingRuleBuiltin",
+			rule:        RankingRuleProximity,
+			wantBuiltin: true,
+		},
+		{
+			name:          "TestRankingRuleAsc",
+			rule:          Asc("price"),
+			wantAttribute: "price",
+			wantCustom:    true,
+		},
+		{
+			name:           "TestRankingRuleDescNested",
+			rule:           Desc("author.rank"),
+			wantAttribute:  "author.rank",
+			wantDescending: true,
+			wantCustom:     true,
+		},
+		{
+			name:    "TestRankingRuleTypo",
+			rule:    "attribut",
+			wantErr: `unknown ranking rule "attribut"`,
+		},
+		{
+			name:    "TestRankingRuleUnknownOrder",
+			rule:    "price:ascending",
+			wantErr: `unknown ranking rule "price:ascending"`,
+		},
+		{
+			name:    "TestRankingRuleNoAttribute",
+			rule:    Asc(""),
+			wantErr: `unknown ranking rule ":asc"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantBuiltin, tt.rule.IsBuiltin())
+			attribute, descending, ok := tt.rule.Attribute()
+			require.Equal(t, tt.wantAttribute, attribute)
+			require.Equal(t, tt.wantDescending, descending)
+			require.Equal(t, tt.wantCustom, ok)
+
+			got, err := ParseRankingRule(tt.rule.String())
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				require.EqualError(t, tt.rule.Validate(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, tt.rule.Validate())
+			require.Equal(t, tt.rule, got)
+		})
+	}
+}
+
+func TestParseRankingRules(t *testing.T) {
+	rules := []RankingRule{RankingRuleWords, Desc("release_date"), RankingRuleSort, Asc("title")}
+	formatted := FormatRankingRules(rules)
+	require.Equal(t, []string{"words", "release_date:desc", "sort", "title:asc"}, formatted)
+	parsed, err := ParseRankingRules(formatted)
+	require.NoError(t, err)
+	require.Equal(t, rules, parsed)
+
+	_, err = ParseRankingRules([]string{"words", "attribut", "typo", "words", "price:ascending"})
+	require.Equal(t, &SettingsValidationError{Problems: []string{
+		`rankingRules[3]: duplicate "words"`,
+		`rankingRules[1]: unknown ranking rule "attribut"`,
+		`rankingRules[4]: unknown ranking rule "price:ascending"`,
+	}}, err)
+
+	require.Equal(t, defaultRankingRules, FormatRankingRules(DefaultRankingRules()))
+}
+
+func TestIndex_UpdateTypedRankingRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		client  *Client
+		request []RankingRule
+		wantErr bool
+	}{
+		{
+			name:    "TestIndexBasicUpdateTypedRankingRules",
+			client:  defaultClient,
+			request: []RankingRule{RankingRuleTypo, RankingRuleWords, Desc("year")},
+		},
+		{
+			name:    "TestIndexUpdateTypedRankingRulesWithCustomClient",
+			client:  customClient,
+			request: []RankingRule{Asc("title"), RankingRuleExactness},
+		},
+		{
+			name:    "TestIndexUpdateTypedRankingRulesInvalid",
+			client:  defaultClient,
+			request: []RankingRule{RankingRuleTypo, "exactnes", RankingRuleTypo},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.client
+			i := c.Index("indexUID")
+			t.Cleanup(cleanup(c))
+
+			gotTask, err := i.UpdateTypedRankingRules(tt.request)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.IsType(t, &SettingsValidationError{}, err)
+				require.Nil(t, gotTask)
+
+				gotResp, err := i.GetTypedRankingRules()
+				require.NoError(t, err)
+				require.Equal(t, DefaultRankingRules(), gotResp)
+				return
+			}
+			require.NoError(t, err)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetTypedRankingRules()
+			require.NoError(t, err)
+			require.Equal(t, tt.request, gotResp)
+		})
+	}
+}
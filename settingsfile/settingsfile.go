@@ -0,0 +1,323 @@
+// Package settingsfile loads index settings and index definitions from JSON,
+// YAML or TOML files, to keep the configuration of indexes as code.
+//
+// Fields are named like in the JSON of meilisearch.Settings in every format,
+// and unknown fields are rejected. A settings file holds the settings at its
+// top level:
+//
+//	rankingRules: [words, typo, proximity, attribute, sort, exactness]
+//	filterableAttributes: [genre, year]
+//	synonyms:
+//	  tale: [story, fable]
+//
+// An index definitions file lists indexes under "indexes":
+//
+//	[[indexes]]
+//	uid = "books"
+//	primaryKey = "id"
+//
+//	[indexes.settings]
+//	sortableAttributes = ["year"]
+//
+// Loaded settings are validated with meilisearch.ValidateSettings.
+package settingsfile
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/meilisearch/meilisearch-go"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// Format is the format of a settings or index definitions file
+type Format string
+
+const (
+	JSON Format = "json"
+	YAML Format = "yaml"
+	TOML Format = "toml"
+)
+
+// FormatOf returns the format of the file at path from its extension:
+// ".json", ".yaml", ".yml" or ".toml"
+func FormatOf(filePath string) (Format, error) {
+	switch strings.ToLower(path.Ext(filePath)) {
+	case ".json":
+		return JSON, nil
+	case ".yaml", ".yml":
+		return YAML, nil
+	case ".toml":
+		return TOML, nil
+	default:
+		return "", errors.Errorf("unknown format of %q, the extension must be .json, .yaml, .yml or .toml", filePath)
+	}
+}
+
+// IndexDefinition declares an index, to be created or updated with Ensure
+type IndexDefinition struct {
+	UID        string                `json:"uid"`
+	PrimaryKey string                `json:"primaryKey,omitempty"`
+	Settings   *meilisearch.Settings `json:"settings,omitempty"`
+}
+
+// Ensure makes sure the index exists with the primary key and the settings of
+// the definition, see meilisearch.Client.EnsureIndex.
+func (d IndexDefinition) Ensure(ctx context.Context, client *meilisearch.Client) (*meilisearch.Index, error) {
+	return client.EnsureIndex(ctx, d.UID, d.PrimaryKey, d.Settings)
+}
+
+type definitionsFile struct {
+	Indexes []IndexDefinition `json:"indexes"`
+}
+
+// LoadSettings loads the settings of the file at path, whose format is given
+// by its extension.
+func LoadSettings(filePath string) (*meilisearch.Settings, error) {
+	data, format, err := readFile(os.ReadFile, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeSettings(data, format)
+}
+
+// LoadSettingsFS loads the settings of the file at path in fsys, whose format
+// is given by its extension.
+func LoadSettingsFS(fsys fs.FS, filePath string) (*meilisearch.Settings, error) {
+	data, format, err := readFile(func(name string) ([]byte, error) {
+		return fs.ReadFile(fsys, name)
+	}, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeSettings(data, format)
+}
+
+// DecodeSettings decodes and validates the settings of data in format.
+func DecodeSettings(data []byte, format Format) (*meilisearch.Settings, error) {
+	settings := &meilisearch.Settings{}
+	if err := decode(data, format, settings); err != nil {
+		return nil, errors.Wrap(err, "could not decode settings")
+	}
+	if err := meilisearch.ValidateSettings(settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// LoadIndexDefinitions loads the index definitions of the file at path, whose
+// format is given by its extension.
+func LoadIndexDefinitions(filePath string) ([]IndexDefinition, error) {
+	data, format, err := readFile(os.ReadFile, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeIndexDefinitions(data, format)
+}
+
+// LoadIndexDefinitionsFS loads the index definitions of all the files of fsys
+// matching pattern (e.g. "indexes/*.yaml", see fs.Glob), whose formats are
+// given by their extensions. A uid may only be defined once.
+func LoadIndexDefinitionsFS(fsys fs.FS, pattern string) ([]IndexDefinition, error) {
+	paths, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not list %q", pattern)
+	}
+	var definitions []IndexDefinition
+	definedIn := map[string]string{}
+	for _, filePath := range paths {
+		data, format, err := readFile(func(name string) ([]byte, error) {
+			return fs.ReadFile(fsys, name)
+		}, filePath)
+		if err != nil {
+			return nil, err
+		}
+		fileDefinitions, err := DecodeIndexDefinitions(data, format)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %q", filePath)
+		}
+		for _, definition := range fileDefinitions {
+			if other, ok := definedIn[definition.UID]; ok {
+				return nil, errors.Errorf("index %q is defined in both %q and %q", definition.UID, other, filePath)
+			}
+			definedIn[definition.UID] = filePath
+		}
+		definitions = append(definitions, fileDefinitions...)
+	}
+	return definitions, nil
+}
+
+// DecodeIndexDefinitions decodes and validates the index definitions of data
+// in format.
+func DecodeIndexDefinitions(data []byte, format Format) ([]IndexDefinition, error) {
+	file := &definitionsFile{}
+	if err := decode(data, format, file); err != nil {
+		return nil, errors.Wrap(err, "could not decode index definitions")
+	}
+
+	seen := map[string]bool{}
+	for j, definition := range file.Indexes {
+		if err := validateUID(definition.UID); err != nil {
+			return nil, errors.Wrapf(err, "indexes[%d]", j)
+		}
+		if seen[definition.UID] {
+			return nil, errors.Errorf("indexes[%d]: index %q is defined twice", j, definition.UID)
+		}
+		seen[definition.UID] = true
+		if definition.Settings != nil {
+			if err := meilisearch.ValidateSettings(definition.Settings); err != nil {
+				return nil, errors.Wrapf(err, "indexes[%d] (%q)", j, definition.UID)
+			}
+		}
+	}
+	return file.Indexes, nil
+}
+
+func readFile(readFile func(name string) ([]byte, error), filePath string) ([]byte, Format, error) {
+	format, err := FormatOf(filePath)
+	if err != nil {
+		return nil, "", err
+	}
+	data, err := readFile(filePath)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "could not read %q", filePath)
+	}
+	return data, format, nil
+}
+
+// decode decodes data in format into v, rejecting the fields unknown to the
+// type of v. Values are converted to JSON to be decoded with the JSON names
+// of the fields whatever the format.
+func decode(data []byte, format Format, v interface{}) error {
+	var (
+		value interface{}
+		err   error
+	)
+	switch format {
+	case JSON:
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		err = decoder.Decode(&value)
+	case YAML:
+		err = yaml.Unmarshal(data, &value)
+	case TOML:
+		var table map[string]interface{}
+		_, err = toml.Decode(string(data), &table)
+		value = table
+	default:
+		return errors.Errorf("unknown format %q", format)
+	}
+	if err != nil {
+		return err
+	}
+	if value == nil {
+		// Empty file
+		value = map[string]interface{}{}
+	}
+
+	if err := checkFields(value, reflect.TypeOf(v), ""); err != nil {
+		return err
+	}
+	if format != JSON {
+		if data, err = json.Marshal(value); err != nil {
+			return err
+		}
+	}
+	return json.Unmarshal(data, v)
+}
+
+// checkFields returns an error if value holds an object field that is not a
+// field of t, located by path
+func checkFields(value interface{}, t reflect.Type, fieldPath string) error {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			// Reported when decoding
+			return nil
+		}
+		fields := jsonFields(t)
+		keys := make([]string, 0, len(object))
+		for key := range object {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			field, ok := fields[key]
+			if !ok {
+				return errors.Errorf("unknown field %q", joinFieldPath(fieldPath, key))
+			}
+			if err := checkFields(object[key], field.Type, joinFieldPath(fieldPath, key)); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		list, _ := value.([]interface{})
+		for j, element := range list {
+			if err := checkFields(element, t.Elem(), fmt.Sprintf("%s[%d]", fieldPath, j)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		object, _ := value.(map[string]interface{})
+		for key, element := range object {
+			if err := checkFields(element, t.Elem(), fmt.Sprintf("%s[%q]", fieldPath, key)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// jsonFields returns the exported fields of t by JSON name
+func jsonFields(t reflect.Type) map[string]reflect.StructField {
+	fields := map[string]reflect.StructField{}
+	for j := 0; j < t.NumField(); j++ {
+		field := t.Field(j)
+		if !field.IsExported() {
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field
+	}
+	return fields
+}
+
+func joinFieldPath(fieldPath string, key string) string {
+	if fieldPath == "" {
+		return key
+	}
+	return fieldPath + "." + key
+}
+
+// validateUID checks that uid is accepted by Meilisearch as an index uid
+func validateUID(uid string) error {
+	if uid == "" {
+		return errors.New("missing uid")
+	}
+	for _, c := range uid {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			return errors.Errorf("uid %q holds %q, only alphanumeric characters, hyphens and underscores are allowed", uid, c)
+		}
+	}
+	return nil
+}
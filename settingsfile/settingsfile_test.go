@@ -0,0 +1,216 @@
+package settingsfile
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/meilisearch/meilisearch-go"
+	"github.com/stretchr/testify/require"
+)
+
+func testSettings() *meilisearch.Settings {
+	distinct := "isbn"
+	return &meilisearch.Settings{
+		RankingRules:         []string{"words", "typo", "year:desc"},
+		DistinctAttribute:    &distinct,
+		FilterableAttributes: []string{"genre", "year"},
+		StopWords:            []string{},
+		Synonyms:             map[string][]string{"tale": {"story", "fable"}},
+	}
+}
+
+func TestDecodeSettings(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		format  Format
+		want    *meilisearch.Settings
+		wantErr string
+	}{
+		{
+			name:   "TestDecodeSettingsJSON",
+			format: JSON,
+			data: `{
+				"rankingRules": ["words", "typo", "year:desc"],
+				"distinctAttribute": "isbn",
+				"filterableAttributes": ["genre", "year"],
+				"stopWords": [],
+				"synonyms": {"tale": ["story", "fable"]}
+			}`,
+			want: testSettings(),
+		},
+		{
+			name:   "TestDecodeSettingsYAML",
+			format: YAML,
+			data: `
+rankingRules: [words, typo, "year:desc"]
+distinctAttribute: isbn
+filterableAttributes:
+  - genre
+  - year
+stopWords: []
+synonyms:
+  tale: [story, fable]
+`,
+			want: testSettings(),
+		},
+		{
+			name:   "TestDecodeSettingsTOML",
+			format: TOML,
+			data: `
+rankingRules = ["words", "typo", "year:desc"]
+distinctAttribute = "isbn"
+filterableAttributes = ["genre", "year"]
+stopWords = []
+
+[synonyms]
+tale = ["story", "fable"]
+`,
+			want: testSettings(),
+		},
+		{
+			name:   "TestDecodeSettingsEmpty",
+			format: YAML,
+			data:   "",
+			want:   &meilisearch.Settings{},
+		},
+		{
+			name:    "TestDecodeSettingsUnknownField",
+			format:  YAML,
+			data:    "filterableAttribute: [genre]\n",
+			wantErr: `unknown field "filterableAttribute"`,
+		},
+		{
+			name:    "TestDecodeSettingsWrongCase",
+			format:  JSON,
+			data:    `{"StopWords": ["the"]}`,
+			wantErr: `unknown field "StopWords"`,
+		},
+		{
+			name:    "TestDecodeSettingsWrongType",
+			format:  TOML,
+			data:    `stopWords = "the"`,
+			wantErr: "could not decode settings",
+		},
+		{
+			name:    "TestDecodeSettingsInvalid",
+			format:  YAML,
+			data:    "rankingRules: [words, speed]\nsearchableAttributes: [title, '*']\n",
+			wantErr: `invalid settings: rankingRules[1]: unknown ranking rule "speed"; searchableAttributes[1]: "*" must be the only attribute`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeSettings([]byte(tt.data), tt.format)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLoadSettings(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "books.yml")
+	require.NoError(t, os.WriteFile(path, []byte("stopWords: [the, a]\n"), 0o600))
+
+	got, err := LoadSettings(path)
+	require.NoError(t, err)
+	require.Equal(t, &meilisearch.Settings{StopWords: []string{"the", "a"}}, got)
+
+	got, err = LoadSettingsFS(os.DirFS(dir), "books.yml")
+	require.NoError(t, err)
+	require.Equal(t, &meilisearch.Settings{StopWords: []string{"the", "a"}}, got)
+
+	_, err = LoadSettings(filepath.Join(dir, "books.ini"))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "the extension must be .json, .yaml, .yml or .toml")
+
+	_, err = LoadSettings(filepath.Join(dir, "missing.json"))
+	require.True(t, errors.Is(err, os.ErrNotExist))
+}
+
+func TestLoadIndexDefinitionsFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"indexes/books.toml": {Data: []byte(`
+[[indexes]]
+uid = "books"
+primaryKey = "id"
+
+[indexes.settings]
+sortableAttributes = ["year"]
+
+[[indexes]]
+uid = "authors"
+`)},
+		"indexes/movies.yaml": {Data: []byte(`
+indexes:
+  - uid: movies
+    primaryKey: movie_id
+    settings:
+      filterableAttributes: [genre]
+`)},
+		"indexes/README.md":  {Data: []byte("Not a definition")},
+		"duplicate/a.json":   {Data: []byte(`{"indexes": [{"uid": "books"}]}`)},
+		"duplicate/b.json":   {Data: []byte(`{"indexes": [{"uid": "books"}]}`)},
+		"invalid/uid.json":   {Data: []byte(`{"indexes": [{"uid": "my books"}]}`)},
+		"invalid/field.yaml": {Data: []byte("indexes:\n  - uid: books\n    settings:\n      sortable: [year]\n")},
+	}
+
+	got, err := LoadIndexDefinitionsFS(fsys, "indexes/*.*ml")
+	require.NoError(t, err)
+	require.Equal(t, []IndexDefinition{
+		{UID: "books", PrimaryKey: "id", Settings: &meilisearch.Settings{SortableAttributes: []string{"year"}}},
+		{UID: "authors"},
+		{UID: "movies", PrimaryKey: "movie_id", Settings: &meilisearch.Settings{FilterableAttributes: []string{"genre"}}},
+	}, got)
+
+	_, err = LoadIndexDefinitionsFS(fsys, "duplicate/*")
+	require.EqualError(t, err, `index "books" is defined in both "duplicate/a.json" and "duplicate/b.json"`)
+
+	_, err = LoadIndexDefinitionsFS(fsys, "invalid/uid.json")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `uid "my books" holds ' '`)
+
+	_, err = LoadIndexDefinitionsFS(fsys, "invalid/field.yaml")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `unknown field "indexes[0].settings.sortable"`)
+}
+
+func TestIndexDefinition_Ensure(t *testing.T) {
+	client := meilisearch.NewClient(meilisearch.ClientConfig{
+		Host:   "http://localhost:7700",
+		APIKey: "masterKey",
+	})
+	definitions, err := DecodeIndexDefinitions([]byte(`
+indexes:
+  - uid: TestIndexDefinitionEnsure
+    primaryKey: id
+    settings:
+      filterableAttributes: [genre]
+      stopWords: [the]
+`), YAML)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		task, _ := client.DeleteIndex("TestIndexDefinitionEnsure")
+		if task != nil {
+			_, _ = client.WaitForTask(task)
+		}
+	})
+
+	index, err := definitions[0].Ensure(context.Background(), client)
+	require.NoError(t, err)
+	require.Equal(t, "id", index.PrimaryKey)
+	settings, err := index.GetSettings()
+	require.NoError(t, err)
+	require.Equal(t, []string{"genre"}, settings.FilterableAttributes)
+	require.Equal(t, []string{"the"}, settings.StopWords)
+}
@@ -0,0 +1,135 @@
+// Package stopwords ships stop word lists of common languages, to be set as
+// the stop words of an index:
+//
+//	words, err := stopwords.Get(stopwords.English, stopwords.French)
+//	...
+//	task, err := stopwords.Apply(index, stopwords.Subtract(words, []string{"will"}))
+//
+// The lists are derived from the stop word lists of the Snowball project
+// (https://snowballstem.org), under the BSD license reproduced in
+// lists/LICENSE. They are embedded text files holding one word per line in
+// sorted order, so that changes to them are reviewable as diffs. Version
+// changes whenever a list does.
+package stopwords
+
+import (
+	"bufio"
+	"embed"
+	"sort"
+	"strings"
+
+	"github.com/meilisearch/meilisearch-go"
+	"github.com/pkg/errors"
+)
+
+// Version identifies the content of the lists. It changes whenever a list
+// changes, for instance to record the lists an index was configured with.
+const Version = "1"
+
+// Language is the ISO 639-1 code of the language of a list
+type Language string
+
+const (
+	Dutch      Language = "nl"
+	English    Language = "en"
+	French     Language = "fr"
+	German     Language = "de"
+	Italian    Language = "it"
+	Portuguese Language = "pt"
+	Spanish    Language = "es"
+)
+
+//go:embed lists/*.txt
+var lists embed.FS
+
+// Languages returns the languages there is a list for, sorted
+func Languages() []Language {
+	entries, err := lists.ReadDir("lists")
+	if err != nil {
+		// The directory is embedded
+		panic(err)
+	}
+	languages := make([]Language, 0, len(entries))
+	for _, entry := range entries {
+		languages = append(languages, Language(strings.TrimSuffix(entry.Name(), ".txt")))
+	}
+	return languages
+}
+
+// Get returns the stop words of the languages, combined with Combine. An error
+// is returned if there is no list for one of them.
+func Get(languages ...Language) ([]string, error) {
+	words := make([][]string, 0, len(languages))
+	for _, language := range languages {
+		list, err := read(language)
+		if err != nil {
+			return nil, err
+		}
+		words = append(words, list)
+	}
+	return Combine(words...), nil
+}
+
+// read reads the list of language, skipping empty lines and "#" comments
+func read(language Language) ([]string, error) {
+	file, err := lists.Open("lists/" + string(language) + ".txt")
+	if err != nil {
+		return nil, errors.Errorf("no stop words for language %q", language)
+	}
+	defer file.Close()
+
+	var words []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		word := strings.TrimSpace(scanner.Text())
+		if word != "" && !strings.HasPrefix(word, "#") {
+			words = append(words, word)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "could not read stop words of %q", language)
+	}
+	return words, nil
+}
+
+// Combine returns the words of every list, sorted and without duplicates
+func Combine(lists ...[]string) []string {
+	seen := map[string]bool{}
+	combined := []string{}
+	for _, list := range lists {
+		for _, word := range list {
+			if !seen[word] {
+				seen[word] = true
+				combined = append(combined, word)
+			}
+		}
+	}
+	sort.Strings(combined)
+	return combined
+}
+
+// Subtract returns the words of list that are in none of the other lists,
+// sorted and without duplicates. It is handy to keep words meaningful to a
+// dataset searchable, such as "who" for a music catalog.
+func Subtract(list []string, lists ...[]string) []string {
+	removed := map[string]bool{}
+	for _, other := range lists {
+		for _, word := range other {
+			removed[word] = true
+		}
+	}
+	kept := []string{}
+	for _, word := range Combine(list) {
+		if !removed[word] {
+			kept = append(kept, word)
+		}
+	}
+	return kept
+}
+
+// Apply replaces the stop words of index with words, sorted and without
+// duplicates.
+func Apply(index *meilisearch.Index, words []string) (*meilisearch.Task, error) {
+	request := Combine(words)
+	return index.UpdateStopWords(&request)
+}
@@ -0,0 +1,84 @@
+package stopwords
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/meilisearch/meilisearch-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLists(t *testing.T) {
+	require.Equal(t, []Language{German, English, Spanish, French, Italian, Dutch, Portuguese}, Languages())
+	for _, language := range Languages() {
+		t.Run(string(language), func(t *testing.T) {
+			words, err := read(language)
+			require.NoError(t, err)
+			require.NotEmpty(t, words)
+			// Lists are kept sorted and without duplicates for their diffs
+			// to be reviewable
+			require.True(t, sort.StringsAreSorted(words), "list is not sorted")
+			require.Equal(t, Combine(words), words, "list holds duplicates")
+			for _, word := range words {
+				require.Equal(t, strings.ToLower(word), word)
+				require.NotContains(t, word, " ")
+			}
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	english, err := Get(English)
+	require.NoError(t, err)
+	require.Contains(t, english, "the")
+	require.NotContains(t, english, "le")
+
+	both, err := Get(English, French)
+	require.NoError(t, err)
+	require.Contains(t, both, "the")
+	require.Contains(t, both, "le")
+	require.True(t, sort.StringsAreSorted(both))
+
+	none, err := Get()
+	require.NoError(t, err)
+	require.Empty(t, none)
+
+	_, err = Get(English, "xx")
+	require.EqualError(t, err, `no stop words for language "xx"`)
+}
+
+func TestCombineAndSubtract(t *testing.T) {
+	require.Equal(t, []string{"a", "an", "the"}, Combine([]string{"the", "a"}, []string{"an", "the"}))
+	require.Equal(t, []string{}, Combine())
+	require.Equal(t, []string{"a", "an"}, Subtract([]string{"the", "a", "an", "a"}, []string{"the"}, []string{"who"}))
+	require.Equal(t, []string{}, Subtract([]string{"the"}, []string{"the"}))
+}
+
+func TestApply(t *testing.T) {
+	client := meilisearch.NewClient(meilisearch.ClientConfig{
+		Host:   "http://localhost:7700",
+		APIKey: "masterKey",
+	})
+	index := client.Index("TestStopWordsApply")
+	t.Cleanup(func() {
+		task, _ := client.DeleteIndex(index.UID)
+		if task != nil {
+			_, _ = client.WaitForTask(task)
+		}
+	})
+
+	english, err := Get(English)
+	require.NoError(t, err)
+	words := Subtract(english, []string{"who"})
+	task, err := Apply(index, words)
+	require.NoError(t, err)
+	finalTask, err := client.WaitForTask(task)
+	require.NoError(t, err)
+	require.Equal(t, meilisearch.TaskStatusSucceeded, finalTask.Status)
+
+	got, err := index.GetStopWords()
+	require.NoError(t, err)
+	require.ElementsMatch(t, words, *got)
+	require.NotContains(t, *got, "who")
+}
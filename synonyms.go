@@ -0,0 +1,276 @@
+package meilisearch
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// SynonymConflict is a word of a synonyms file given synonyms by several
+// lines in a way that is probably not intended. The synonyms of every line
+// are kept.
+type SynonymConflict struct {
+	// Word is the conflicting word
+	Word string
+
+	// Lines are the lines giving synonyms to Word, from 1
+	Lines []int
+
+	// Reason explains the conflict
+	Reason string
+}
+
+// String returns the conflict formatted as
+// `"tv" (lines 3, 8): is in several equivalence groups, which are not merged`
+func (c SynonymConflict) String() string {
+	lines := make([]string, 0, len(c.Lines))
+	for _, line := range c.Lines {
+		lines = append(lines, strconv.Itoa(line))
+	}
+	return fmt.Sprintf("%q (lines %s): %s", c.Word, strings.Join(lines, ", "), c.Reason)
+}
+
+// ParseSolrSynonyms parses synonyms in the synonyms.txt format of Solr into
+// the synonyms expected by UpdateSynonyms:
+//
+//	# Equivalent words, each one is a synonym of the others
+//	tv, television, telly
+//	# Explicit mappings, "ipod" and "i-pod" get "ipod" and "music player" as synonyms
+//	ipod, i-pod => ipod, music player
+//
+// Lines starting with "#" are comments, "\" escapes the next character (e.g.
+// "\," or "\=>") and spaces in a word are collapsed. Lines giving synonyms to
+// the same word are merged. Words in several equivalence groups, or both in an
+// equivalence group and mapped explicitly, are returned as conflicts as Solr
+// and Meilisearch do not treat them the same way. A *SynonymsSyntaxError is
+// returned if a line is malformed.
+func ParseSolrSynonyms(r io.Reader) (map[string][]string, []SynonymConflict, error) {
+	b := newSynonymsBuilder()
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		if err := b.addSolrLine(text, line); err != nil {
+			return nil, nil, &SynonymsSyntaxError{Line: line, Err: err}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, errors.Wrap(err, "could not read synonyms")
+	}
+	return b.synonyms, b.conflicts(), nil
+}
+
+// ParseWordNetSynonyms parses synonyms in the prolog format of WordNet (the
+// wn_s.pl file) into the synonyms expected by UpdateSynonyms, the words of a
+// synset being synonyms of each other:
+//
+//	s(102084071,1,'dog',n,1,42).
+//	s(102084071,2,'domestic dog',n,1,0).
+//
+// A word belongs to a synset per meaning, so being in several synsets is not
+// a conflict and their synonyms are merged. Lines starting with "%" are
+// comments. A *SynonymsSyntaxError is returned if a line is malformed.
+func ParseWordNetSynonyms(r io.Reader) (map[string][]string, error) {
+	var (
+		synsetIDs []string
+		synsets   = map[string][]string{}
+		lines     = map[string]int{}
+	)
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "%") {
+			continue
+		}
+		match := wordNetLine.FindStringSubmatch(text)
+		if match == nil {
+			return nil, &SynonymsSyntaxError{Line: line, Err: errors.Errorf("%q is not a s/6 fact", text)}
+		}
+		id, word := match[1], normalizeSynonym(strings.ReplaceAll(match[2], "''", "'"))
+		if word == "" {
+			return nil, &SynonymsSyntaxError{Line: line, Err: errors.New("empty word")}
+		}
+		if _, ok := synsets[id]; !ok {
+			synsetIDs = append(synsetIDs, id)
+			lines[id] = line
+		}
+		synsets[id] = append(synsets[id], word)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "could not read synonyms")
+	}
+
+	b := newSynonymsBuilder()
+	for _, id := range synsetIDs {
+		b.addGroup(synsets[id], lines[id])
+	}
+	return b.synonyms, nil
+}
+
+// wordNetLine matches a fact of wn_s.pl:
+// s(synset_id,w_num,'word',ss_type,sense_number,tag_count).
+var wordNetLine = regexp.MustCompile(`^s\((\d+),\d+,'((?:[^']|'')*)',[a-z],\d+,\d+\)\.$`)
+
+// synonymsBuilder merges equivalence groups and explicit mappings into
+// synonyms, keeping the lines they come from to report conflicts
+type synonymsBuilder struct {
+	synonyms map[string][]string
+	seen     map[string]map[string]bool
+	// groupLines and mappingLines are the lines of the equivalence groups
+	// holding each word and of the explicit mappings from each word
+	groupLines   map[string][]int
+	mappingLines map[string][]int
+}
+
+func newSynonymsBuilder() *synonymsBuilder {
+	return &synonymsBuilder{
+		synonyms:     map[string][]string{},
+		seen:         map[string]map[string]bool{},
+		groupLines:   map[string][]int{},
+		mappingLines: map[string][]int{},
+	}
+}
+
+// addSolrLine adds a line of a Solr synonyms file, neither empty nor a
+// comment
+func (b *synonymsBuilder) addSolrLine(text string, line int) error {
+	sides := splitUnescaped(text, "=>")
+	switch len(sides) {
+	case 1:
+		words, err := parseSolrWords(sides[0])
+		if err != nil {
+			return err
+		}
+		b.addGroup(words, line)
+	case 2:
+		from, err := parseSolrWords(sides[0])
+		if err != nil {
+			return errors.Wrap(err, "left of =>")
+		}
+		to, err := parseSolrWords(sides[1])
+		if err != nil {
+			return errors.Wrap(err, "right of =>")
+		}
+		for _, word := range from {
+			b.add(word, to)
+			b.mappingLines[word] = append(b.mappingLines[word], line)
+		}
+	default:
+		return errors.New(`several "=>"`)
+	}
+	return nil
+}
+
+// addGroup makes every word a synonym of the others
+func (b *synonymsBuilder) addGroup(words []string, line int) {
+	for _, word := range words {
+		b.add(word, words)
+		b.groupLines[word] = append(b.groupLines[word], line)
+	}
+}
+
+// add adds synonyms to the synonyms of word, except word itself and the
+// synonyms it already has
+func (b *synonymsBuilder) add(word string, synonyms []string) {
+	seen := b.seen[word]
+	if seen == nil {
+		seen = map[string]bool{word: true}
+		b.seen[word] = seen
+	}
+	for _, synonym := range synonyms {
+		if !seen[synonym] {
+			seen[synonym] = true
+			b.synonyms[word] = append(b.synonyms[word], synonym)
+		}
+	}
+}
+
+// conflicts returns the words in several equivalence groups, or both in an
+// equivalence group and mapped explicitly, sorted by word
+func (b *synonymsBuilder) conflicts() []SynonymConflict {
+	var conflicts []SynonymConflict
+	for word, groupLines := range b.groupLines {
+		mappingLines := b.mappingLines[word]
+		switch {
+		case len(mappingLines) != 0:
+			lines := append(append([]int{}, groupLines...), mappingLines...)
+			sort.Ints(lines)
+			conflicts = append(conflicts, SynonymConflict{
+				Word:   word,
+				Lines:  lines,
+				Reason: "is both in an equivalence group and mapped explicitly",
+			})
+		case len(groupLines) > 1:
+			conflicts = append(conflicts, SynonymConflict{
+				Word:   word,
+				Lines:  groupLines,
+				Reason: "is in several equivalence groups, which are not merged",
+			})
+		}
+	}
+	sort.Slice(conflicts, func(j, k int) bool {
+		return conflicts[j].Word < conflicts[k].Word
+	})
+	return conflicts
+}
+
+// parseSolrWords parses a comma separated list of words, without duplicates
+func parseSolrWords(text string) ([]string, error) {
+	var words []string
+	seen := map[string]bool{}
+	for _, field := range splitUnescaped(text, ",") {
+		word := normalizeSynonym(unescapeSolr(field))
+		if word == "" {
+			return nil, errors.New("empty word")
+		}
+		if !seen[word] {
+			seen[word] = true
+			words = append(words, word)
+		}
+	}
+	return words, nil
+}
+
+// splitUnescaped splits text around the occurrences of sep not escaped by a
+// backslash, the escapes being kept
+func splitUnescaped(text string, sep string) []string {
+	var parts []string
+	start := 0
+	for j := 0; j < len(text); j++ {
+		switch {
+		case text[j] == '\\':
+			j++
+		case strings.HasPrefix(text[j:], sep):
+			parts = append(parts, text[start:j])
+			start = j + len(sep)
+			j = start - 1
+		}
+	}
+	return append(parts, text[start:])
+}
+
+// unescapeSolr removes the backslashes escaping characters
+func unescapeSolr(text string) string {
+	var b strings.Builder
+	for j := 0; j < len(text); j++ {
+		if text[j] == '\\' && j+1 < len(text) {
+			j++
+		}
+		b.WriteByte(text[j])
+	}
+	return b.String()
+}
+
+// normalizeSynonym trims the spaces around a word and collapses the spaces in
+// it
+func normalizeSynonym(word string) string {
+	return strings.Join(strings.Fields(word), " ")
+}
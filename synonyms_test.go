@@ -0,0 +1,175 @@
+package meilisearch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSolrSynonyms(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		wantSynonyms  map[string][]string
+		wantConflicts []string
+		wantErr       string
+	}{
+		{
+			name:         "TestParseSolrSynonymsEmpty",
+			input:        "# no synonyms\n\n",
+			wantSynonyms: map[string][]string{},
+		},
+		{
+			name:  "TestParseSolrSynonymsEquivalence",
+			input: "tv, television,  telly\n# comment\nGB,gib,gigabyte,gigabytes, gib\n",
+			wantSynonyms: map[string][]string{
+				"tv":         {"television", "telly"},
+				"television": {"tv", "telly"},
+				"telly":      {"tv", "television"},
+				"GB":         {"gib", "gigabyte", "gigabytes"},
+				"gib":        {"GB", "gigabyte", "gigabytes"},
+				"gigabyte":   {"GB", "gib", "gigabytes"},
+				"gigabytes":  {"GB", "gib", "gigabyte"},
+			},
+		},
+		{
+			name:  "TestParseSolrSynonymsExplicit",
+			input: "ipod, i-pod => ipod, music   player\nsea biscuit => seabiscuit\ni-pod => walkman",
+			wantSynonyms: map[string][]string{
+				"ipod":        {"music player"},
+				"i-pod":       {"ipod", "music player", "walkman"},
+				"sea biscuit": {"seabiscuit"},
+			},
+		},
+		{
+			name:  "TestParseSolrSynonymsEscapes",
+			input: `a\,b, c\=>d => e\\f`,
+			wantSynonyms: map[string][]string{
+				"a,b":  {`e\f`},
+				"c=>d": {`e\f`},
+			},
+		},
+		{
+			name:  "TestParseSolrSynonymsConflicts",
+			input: "tv, television\ncar, auto\ntv, telly\ncar => automobile\n",
+			wantSynonyms: map[string][]string{
+				"tv":         {"television", "telly"},
+				"television": {"tv"},
+				"telly":      {"tv"},
+				"car":        {"auto", "automobile"},
+				"auto":       {"car"},
+			},
+			wantConflicts: []string{
+				`"car" (lines 2, 4): is both in an equivalence group and mapped explicitly`,
+				`"tv" (lines 1, 3): is in several equivalence groups, which are not merged`,
+			},
+		},
+		{
+			name:    "TestParseSolrSynonymsEmptyWord",
+			input:   "tv, television\ntv,, telly",
+			wantErr: "synonyms line 2: empty word",
+		},
+		{
+			name:    "TestParseSolrSynonymsEmptyMapping",
+			input:   "ipod =>",
+			wantErr: "synonyms line 1: right of =>: empty word",
+		},
+		{
+			name:    "TestParseSolrSynonymsSeveralMappings",
+			input:   "a => b => c",
+			wantErr: `synonyms line 1: several "=>"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSynonyms, gotConflicts, err := ParseSolrSynonyms(strings.NewReader(tt.input))
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				require.IsType(t, &SynonymsSyntaxError{}, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantSynonyms, gotSynonyms)
+			var conflicts []string
+			for _, conflict := range gotConflicts {
+				conflicts = append(conflicts, conflict.String())
+			}
+			require.Equal(t, tt.wantConflicts, conflicts)
+		})
+	}
+}
+
+func TestParseWordNetSynonyms(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		wantSynonyms map[string][]string
+		wantErr      string
+	}{
+		{
+			name: "TestParseWordNetSynonyms",
+			input: "% wn_s.pl\n" +
+				"s(102084071,1,'dog',n,1,42).\n" +
+				"s(102084071,2,'domestic dog',n,1,0).\n" +
+				"s(102084071,3,'Canis familiaris',n,1,0).\n" +
+				"s(100001740,1,'entity',n,1,11).\n" +
+				"s(110114209,1,'frump',n,1,0).\n" +
+				"s(110114209,2,'dog',n,2,0).\n" +
+				"s(106845599,1,'hasn''t',v,1,0).\n" +
+				"s(106845599,2,'has not',v,1,0).\n",
+			wantSynonyms: map[string][]string{
+				"dog":              {"domestic dog", "Canis familiaris", "frump"},
+				"domestic dog":     {"dog", "Canis familiaris"},
+				"Canis familiaris": {"dog", "domestic dog"},
+				"frump":            {"dog"},
+				"hasn't":           {"has not"},
+				"has not":          {"hasn't"},
+			},
+		},
+		{
+			name:    "TestParseWordNetSynonymsMalformed",
+			input:   "s(102084071,1,'dog',n,1,42).\ng(102084071,'a member of the genus Canis').\n",
+			wantErr: `synonyms line 2: "g(102084071,'a member of the genus Canis')." is not a s/6 fact`,
+		},
+		{
+			name:    "TestParseWordNetSynonymsEmptyWord",
+			input:   "s(102084071,1,' ',n,1,42).",
+			wantErr: "synonyms line 1: empty word",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSynonyms, err := ParseWordNetSynonyms(strings.NewReader(tt.input))
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantSynonyms, gotSynonyms)
+		})
+	}
+}
+
+func TestIndex_UpdateSynonymsFromSolr(t *testing.T) {
+	c := defaultClient
+	t.Cleanup(cleanup(c))
+	SetUpIndexForFaceting()
+	i := c.Index("indexUID")
+
+	synonyms, conflicts, err := ParseSolrSynonyms(strings.NewReader("tale, story\nhp => harry potter\n"))
+	require.NoError(t, err)
+	require.Empty(t, conflicts)
+
+	task, err := i.UpdateSynonyms(&synonyms)
+	require.NoError(t, err)
+	testWaitForTask(t, i, task)
+
+	got, err := i.GetSynonyms()
+	require.NoError(t, err)
+	require.Equal(t, &map[string][]string{
+		"tale":  {"story"},
+		"story": {"tale"},
+		"hp":    {"harry potter"},
+	}, got)
+}
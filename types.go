@@ -14,6 +14,7 @@ import (
 type Client struct {
 	config     ClientConfig
 	httpClient *fasthttp.Client
+	aliases    AliasStore
 }
 
 // Index is the type that represent an index in Meilisearch
@@ -25,7 +26,9 @@ type Index struct {
 	client     *Client
 }
 
-// Settings is the type that represents the settings in Meilisearch
+// Settings is the type that represents the settings in Meilisearch.
+// TypoTolerance, Faceting and Pagination require Meilisearch v0.28 or later,
+// SeparatorTokens, NonSeparatorTokens and Dictionary v1.3 or later.
 type Settings struct {
 	RankingRules         []string            `json:"rankingRules,omitempty"`
 	DistinctAttribute    *string             `json:"distinctAttribute,omitempty"`
@@ -35,6 +38,39 @@ type Settings struct {
 	Synonyms             map[string][]string `json:"synonyms,omitempty"`
 	FilterableAttributes []string            `json:"filterableAttributes,omitempty"`
 	SortableAttributes   []string            `json:"sortableAttributes,omitempty"`
+	TypoTolerance        *TypoTolerance      `json:"typoTolerance,omitempty"`
+	Faceting             *Faceting           `json:"faceting,omitempty"`
+	Pagination           *Pagination         `json:"pagination,omitempty"`
+	SeparatorTokens      []string            `json:"separatorTokens,omitempty"`
+	NonSeparatorTokens   []string            `json:"nonSeparatorTokens,omitempty"`
+	Dictionary           []string            `json:"dictionary,omitempty"`
+}
+
+// TypoTolerance is the type that represents the typo tolerance setting in
+// Meilisearch
+type TypoTolerance struct {
+	Enabled             *bool                `json:"enabled,omitempty"`
+	MinWordSizeForTypos *MinWordSizeForTypos `json:"minWordSizeForTypos,omitempty"`
+	DisableOnWords      []string             `json:"disableOnWords,omitempty"`
+	DisableOnAttributes []string             `json:"disableOnAttributes,omitempty"`
+}
+
+// MinWordSizeForTypos is the minimum number of characters a word must hold
+// to accept one or two typos
+type MinWordSizeForTypos struct {
+	OneTypo  int64 `json:"oneTypo,omitempty"`
+	TwoTypos int64 `json:"twoTypos,omitempty"`
+}
+
+// Faceting is the type that represents the faceting setting in Meilisearch
+type Faceting struct {
+	MaxValuesPerFacet int64 `json:"maxValuesPerFacet"`
+}
+
+// Pagination is the type that represents the pagination setting in
+// Meilisearch
+type Pagination struct {
+	MaxTotalHits int64 `json:"maxTotalHits"`
 }
 
 // Version is the type that represents the versions in Meilisearch
@@ -103,6 +139,12 @@ type Details struct {
 	Synonyms             map[string][]string `json:"synonyms,omitempty"`
 	FilterableAttributes []string            `json:"filterableAttributes,omitempty"`
 	SortableAttributes   []string            `json:"sortableAttributes,omitempty"`
+	TypoTolerance        *TypoTolerance      `json:"typoTolerance,omitempty"`
+	Faceting             *Faceting           `json:"faceting,omitempty"`
+	Pagination           *Pagination         `json:"pagination,omitempty"`
+	SeparatorTokens      []string            `json:"separatorTokens,omitempty"`
+	NonSeparatorTokens   []string            `json:"nonSeparatorTokens,omitempty"`
+	Dictionary           []string            `json:"dictionary,omitempty"`
 }
 
 type ResultTask struct {
@@ -200,16 +242,29 @@ type SearchResponse struct {
 	ExhaustiveFacetsCount interface{}   `json:"exhaustiveFacetsCount,omitempty"`
 }
 
-// DocumentsRequest is the request body for list documents method
+// DocumentsRequest is the request body for list documents method, Filter
+// requires Meilisearch v1.2 or later
 type DocumentsRequest struct {
 	Offset               int64    `json:"offset,omitempty"`
 	Limit                int64    `json:"limit,omitempty"`
 	AttributesToRetrieve []string `json:"attributesToRetrieve,omitempty"`
+	Filter               string   `json:"filter,omitempty"`
+}
+
+// DeleteDocumentsByFilterRequest is the request body for delete documents by
+// filter method
+type DeleteDocumentsByFilterRequest struct {
+	Filter interface{} `json:"filter"`
 }
 
 // RawType is an alias for raw byte[]
 type RawType []byte
 
+// SwapIndexesParams is a pair of indexes to swap with Client.SwapIndexes
+type SwapIndexesParams struct {
+	Indexes []string `json:"indexes"`
+}
+
 // Health is the request body for set Meilisearch health
 type Health struct {
 	Status string `json:"status"`